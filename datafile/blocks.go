@@ -0,0 +1,123 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// Compressed returns whether the file's subjects are in compressed blocks.
+func (r *Reader) Compressed() bool {
+	return r.Header.Blocks != nil
+}
+
+// blockRange returns where a compressed block is in the payload.
+func (r *Reader) blockRange(i int) (int, int, error) {
+	offsets := r.Header.Blocks.BlockByteOffset
+	start, end := int(offsets[i]), len(r.payload)
+	if i+1 < len(offsets) {
+		end = int(offsets[i+1])
+	}
+	if start > end || end > len(r.payload) {
+		return 0, 0, fmt.Errorf("block %d: bad offsets %d to %d in %d bytes", i, start, end, len(r.payload))
+	}
+	return start, end, nil
+}
+
+// block returns a decompressed block.  The last one is kept, so reading
+// subjects in ID order decompresses each block once.
+func (r *Reader) block(i int) ([]byte, error) {
+	r.blockMu.Lock()
+	defer r.blockMu.Unlock()
+	if r.blockIndex == i {
+		return r.blockData, nil
+	}
+
+	start, end, err := r.blockRange(i)
+	if err != nil {
+		return nil, err
+	}
+	data := r.payload[start:end]
+	if r.Header.Blocks.GetCompression() == proto.DataFileBlocks_DEFLATE {
+		fr := flate.NewReader(bytes.NewReader(data))
+		data, err = io.ReadAll(fr)
+		fr.Close()
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	if want := int(r.Header.Blocks.BlockLength[i]); len(data) != want {
+		return nil, fmt.Errorf("block %d: %d bytes, want %d", i, len(data), want)
+	}
+	if sums := r.Header.Blocks.BlockCrc32; i < len(sums) && crc32.ChecksumIEEE(data) != sums[i] {
+		return nil, fmt.Errorf("block %d: bad checksum", i)
+	}
+
+	r.blockIndex, r.blockData = i, data
+	return data, nil
+}
+
+// compressBlocks groups the subjects in an uncompressed payload into blocks
+// of at least blockSize bytes, compresses each one, and updates the header's
+// offsets to point into the blocks.
+func compressBlocks(h *proto.DataFileHeader, payload []byte, blockSize int) ([]byte, error) {
+	offsets := h.SubjectByteOffset
+	b := &proto.DataFileBlocks{
+		Compression:     proto.DataFileBlocks_DEFLATE.Enum(),
+		BlockBySubject:  make([]uint32, len(offsets)),
+		TargetBlockSize: gproto.Uint32(uint32(blockSize)),
+	}
+
+	var out bytes.Buffer
+	fw, err := flate.NewWriter(&out, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	flush := func(block []byte) error {
+		b.BlockByteOffset = append(b.BlockByteOffset, uint32(out.Len()))
+		b.BlockLength = append(b.BlockLength, uint32(len(block)))
+		b.BlockCrc32 = append(b.BlockCrc32, crc32.ChecksumIEEE(block))
+		fw.Reset(&out)
+		if _, err := fw.Write(block); err != nil {
+			return err
+		}
+		return fw.Close()
+	}
+
+	blockStart := 0
+	for id, offset := range offsets {
+		if int(offset)-blockStart >= blockSize {
+			if err := flush(payload[blockStart:offset]); err != nil {
+				return nil, err
+			}
+			blockStart = int(offset)
+		}
+		b.BlockBySubject[id] = uint32(len(b.BlockLength))
+		offsets[id] = offset - uint32(blockStart)
+	}
+	if err := flush(payload[blockStart:]); err != nil {
+		return nil, err
+	}
+
+	h.Blocks = b
+	return out.Bytes(), nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command build writes a data file from a stream of length-delimited Subject
+// messages, then reads it back to check that it holds the same subjects.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile"
+)
+
+var (
+	subjects  = flag.String("subjects", "", "Length-delimited Subject messages")
+	deleted   = flag.String("deleted", "", "Comma-separated IDs of subjects that no longer have any data")
+	output    = flag.String("output", "", "File to write the data file to")
+	blockSize = flag.Int("block_size", 0, "Compress the subjects in blocks of at least this many bytes, or 0 to not compress")
+	verify    = flag.Bool("verify", true, "Read the data file back and check it after writing")
+)
+
+func parseIDs(s string) ([]int64, error) {
+	var ret []int64
+	for _, field := range strings.Split(s, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, id)
+	}
+	return ret, nil
+}
+
+func run() error {
+	if *subjects == "" || *output == "" {
+		return errors.New("-subjects and -output are required")
+	}
+	deletedIDs, err := parseIDs(*deleted)
+	if err != nil {
+		return fmt.Errorf("-deleted: %w", err)
+	}
+
+	in, err := os.Open(*subjects)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	s, err := datafile.ReadSubjects(in)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *subjects, err)
+	}
+
+	w := datafile.NewWriter()
+	w.BlockSize = *blockSize
+	if err := w.AddAll(s); err != nil {
+		return err
+	}
+	for _, id := range deletedIDs {
+		w.Delete(id)
+	}
+
+	out, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := w.Write(out); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d subjects and %d deleted IDs\n", len(s), len(deletedIDs))
+
+	if !*verify {
+		return nil
+	}
+	r, err := datafile.Open(*output, datafile.Options{})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return datafile.Verify(r, s, deletedIDs)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command compress rewrites a data file with its subjects in compressed
+// blocks, and reports how the file size and the time to read subjects change
+// with the block size.
+//
+//	compress -block_sizes 0,4096,16384,65536 data.bin
+//	compress -block_size 16384 -output data.compressed.bin data.bin
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+var (
+	blockSizes = flag.String("block_sizes", "0,1024,4096,16384,65536,262144", "Comma-separated block sizes to compare, where 0 is uncompressed")
+	samples    = flag.Int("samples", 2000, "Number of random subjects to read for each block size")
+	blockSize  = flag.Int("block_size", 16384, "Block size of the -output file")
+	output     = flag.String("output", "", "File to write the compressed data file to")
+)
+
+func build(subjects []*proto.Subject, deleted []int32, size int) ([]byte, error) {
+	w := datafile.NewWriter()
+	w.BlockSize = size
+	if err := w.AddAll(subjects); err != nil {
+		return nil, err
+	}
+	for _, id := range deleted {
+		w.Delete(int64(id))
+	}
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// measure returns the mean time to read one random subject, and the time to
+// read every subject in order.
+func measure(data []byte, ids []int64) (time.Duration, time.Duration, error) {
+	r, err := datafile.NewReader(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	rnd := rand.New(rand.NewSource(1))
+	start := time.Now()
+	for i := 0; i < *samples; i++ {
+		if _, err := r.Subject(ids[rnd.Intn(len(ids))]); err != nil {
+			return 0, 0, err
+		}
+	}
+	random := time.Since(start) / time.Duration(*samples)
+
+	// Start from a new Reader so the last block isn't already decompressed.
+	if r, err = datafile.NewReader(data); err != nil {
+		return 0, 0, err
+	}
+	start = time.Now()
+	if _, err := r.Subjects(); err != nil {
+		return 0, 0, err
+	}
+	return random, time.Since(start), nil
+}
+
+func run() error {
+	if flag.NArg() != 1 {
+		return errors.New("usage: compress [flags] data.bin")
+	}
+	r, err := datafile.Open(flag.Arg(0), datafile.Options{})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	subjects, err := r.Subjects()
+	if err != nil {
+		return err
+	}
+	ids := r.IDs()
+	if len(ids) == 0 {
+		return errors.New("no subjects")
+	}
+	deleted := r.Header.DeletedSubjectIds
+
+	if *output != "" {
+		data, err := build(subjects, deleted, *blockSize)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(*output, data, 0644)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "block size\tblocks\tbytes\tratio\trandom read\tfull scan\t")
+	var uncompressed int
+	for _, field := range strings.Split(*blockSizes, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return fmt.Errorf("-block_sizes: %w", err)
+		}
+		data, err := build(subjects, deleted, size)
+		if err != nil {
+			return err
+		}
+		random, scan, err := measure(data, ids)
+		if err != nil {
+			return fmt.Errorf("block size %d: %w", size, err)
+		}
+
+		name, blocks := "none", "-"
+		if size > 0 {
+			name = strconv.Itoa(size)
+			rr, _ := datafile.NewReader(data)
+			blocks = strconv.Itoa(len(rr.Header.Blocks.BlockLength))
+		} else {
+			uncompressed = len(data)
+		}
+		ratio := "-"
+		if uncompressed != 0 {
+			ratio = fmt.Sprintf("%.1f%%", 100*float64(len(data))/float64(uncompressed))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t\n", name, blocks, len(data), ratio,
+			random.Round(time.Microsecond/10), scan.Round(time.Microsecond))
+	}
+	return tw.Flush()
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command delta writes the DataFileDelta that turns one data file into
+// another.  Apply it with the patch command.
+//
+//	delta -output old-to-new.delta old.bin new.bin
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var output = flag.String("output", "", "File to write the delta to")
+
+func run() error {
+	if flag.NArg() != 2 || *output == "" {
+		return errors.New("usage: delta -output old-to-new.delta old.bin new.bin")
+	}
+	base, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	updated, err := os.ReadFile(flag.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	d, err := datafile.MakeDelta(base, updated)
+	if err != nil {
+		return err
+	}
+	data, err := gproto.MarshalOptions{Deterministic: true}.Marshal(d)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d added, %d replaced, %d deleted: %d bytes instead of %d\n",
+		len(d.AddedSubjects), len(d.ReplacedSubjects), len(d.DeletedSubjectIds), len(data), len(updated))
+	return os.WriteFile(*output, data, 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
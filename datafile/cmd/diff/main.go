@@ -0,0 +1,74 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command diff shows what changed between two data files: added and deleted
+// subjects, subjects that moved level, and every changed field.
+//
+//	diff old.bin new.bin
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+)
+
+var (
+	jsonOutput = flag.Bool("json", false, "Write the diff as JSON")
+	mmap       = flag.Bool("mmap", false, "Map the files into memory instead of reading them")
+)
+
+func run() error {
+	if flag.NArg() != 2 {
+		return errors.New("usage: diff [flags] old.bin new.bin")
+	}
+	old, err := datafile.Open(flag.Arg(0), datafile.Options{Mmap: *mmap})
+	if err != nil {
+		return err
+	}
+	defer old.Close()
+	updated, err := datafile.Open(flag.Arg(1), datafile.Options{Mmap: *mmap})
+	if err != nil {
+		return err
+	}
+	defer updated.Close()
+
+	d, err := datafile.DiffFiles(old, updated)
+	if err != nil {
+		return err
+	}
+
+	if *jsonOutput {
+		data, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	datafile.WriteFileDiff(os.Stdout, d)
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
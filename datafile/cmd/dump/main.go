@@ -0,0 +1,140 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command dump prints subjects from a data file as JSON Lines, or a summary
+// of its header with -summary.
+//
+//	dump -id 440,441 data.bin
+//	dump -level 3 -type kanji data.bin
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	ids         = flag.String("id", "", "Comma-separated subject IDs to print")
+	level       = flag.Int("level", 0, "Only print subjects in this level")
+	subjType    = flag.String("type", "", "Only print subjects of this type: radical, kanji or vocabulary")
+	summary     = flag.Bool("summary", false, "Print the number of subjects in each level instead")
+	mmap        = flag.Bool("mmap", false, "Map the file into memory instead of reading it")
+	showDeleted = flag.Bool("deleted", false, "Print the deleted subject IDs instead")
+)
+
+// parseType parses a subject type name like "kanji".
+func parseType(name string) (proto.Subject_Type, error) {
+	t, ok := proto.Subject_Type_value[strings.ToUpper(name)]
+	if !ok || t == int32(proto.Subject_UNKNOWN) {
+		return 0, fmt.Errorf("unknown subject type %q", name)
+	}
+	return proto.Subject_Type(t), nil
+}
+
+func selectIDs(r *datafile.Reader) ([]int64, error) {
+	if *ids != "" {
+		var ret []int64
+		for _, s := range strings.Split(*ids, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, id)
+		}
+		return ret, nil
+	}
+
+	var t proto.Subject_Type
+	if *subjType != "" {
+		var err error
+		if t, err = parseType(*subjType); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case *level != 0 && t != proto.Subject_UNKNOWN:
+		return r.ByLevelAndType(*level, t), nil
+	case *level != 0:
+		return r.ByLevel(*level), nil
+	case t != proto.Subject_UNKNOWN:
+		return r.ByType(t), nil
+	}
+	return r.IDs(), nil
+}
+
+func run() error {
+	if flag.NArg() != 1 {
+		return errors.New("usage: dump [flags] data.bin")
+	}
+	r, err := datafile.Open(flag.Arg(0), datafile.Options{Mmap: *mmap})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if *summary {
+		fmt.Printf("%d subject IDs, %d deleted, %d levels\n",
+			len(r.IDs()), len(r.Header.DeletedSubjectIds), r.MaxLevel())
+		if b := r.Header.Blocks; b != nil {
+			fmt.Printf("%d %s blocks of at least %d bytes\n",
+				len(b.BlockLength), b.GetCompression(), b.GetTargetBlockSize())
+		}
+		for l := 1; l <= r.MaxLevel(); l++ {
+			fmt.Printf("level %2d: %3d radicals %3d kanji %4d vocabulary\n", l,
+				len(r.ByLevelAndType(l, proto.Subject_RADICAL)),
+				len(r.ByLevelAndType(l, proto.Subject_KANJI)),
+				len(r.ByLevelAndType(l, proto.Subject_VOCABULARY)))
+		}
+		return nil
+	}
+	if *showDeleted {
+		for _, id := range r.Header.DeletedSubjectIds {
+			fmt.Println(id)
+		}
+		return nil
+	}
+
+	selected, err := selectIDs(r)
+	if err != nil {
+		return err
+	}
+	for _, id := range selected {
+		s, err := r.Subject(id)
+		if err != nil {
+			return err
+		}
+		b, err := protojson.Marshal(s)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
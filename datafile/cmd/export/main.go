@@ -0,0 +1,166 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command export writes subjects from a data file, or from a stream of
+// length-delimited Subject messages, as JSON Lines or CSV.
+//
+//	export -format csv -level 1-10 -type kanji,vocabulary data.bin
+//	export -subjects subjects.bin -output subjects.jsonl
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/datafile/export"
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+var (
+	subjects = flag.String("subjects", "", "Read length-delimited Subject messages from this file instead of a data file")
+	format   = flag.String("format", "jsonl", "Output format: jsonl or csv")
+	levels   = flag.String("level", "", "Only export subjects in these levels, like 3 or 1-10,15")
+	types    = flag.String("type", "", "Only export subjects of these types, like kanji,vocabulary")
+	output   = flag.String("output", "", "File to write to instead of stdout")
+)
+
+func parseLevels(spec string) (map[int32]bool, error) {
+	ret := map[int32]bool{}
+	for _, part := range strings.Split(spec, ",") {
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		if !isRange {
+			hi = lo
+		}
+		a, err := strconv.Atoi(lo)
+		if err != nil {
+			return nil, fmt.Errorf("bad level %q", part)
+		}
+		b, err := strconv.Atoi(hi)
+		if err != nil || b < a {
+			return nil, fmt.Errorf("bad level range %q", part)
+		}
+		for l := a; l <= b; l++ {
+			ret[int32(l)] = true
+		}
+	}
+	return ret, nil
+}
+
+func parseTypes(spec string) (map[proto.Subject_Type]bool, error) {
+	ret := map[proto.Subject_Type]bool{}
+	for _, name := range strings.Split(spec, ",") {
+		t, ok := proto.Subject_Type_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok || t == int32(proto.Subject_UNKNOWN) {
+			return nil, fmt.Errorf("unknown subject type %q", name)
+		}
+		ret[proto.Subject_Type(t)] = true
+	}
+	return ret, nil
+}
+
+func readSubjects() ([]*proto.Subject, error) {
+	if *subjects != "" {
+		if flag.NArg() != 0 {
+			return nil, errors.New("give either -subjects or a data file, not both")
+		}
+		f, err := os.Open(*subjects)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		s, err := datafile.ReadSubjects(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", *subjects, err)
+		}
+		return s, nil
+	}
+
+	if flag.NArg() != 1 {
+		return nil, errors.New("usage: export [flags] data.bin")
+	}
+	r, err := datafile.Open(flag.Arg(0), datafile.Options{})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return r.Subjects()
+}
+
+func run() error {
+	var filter export.Filter
+	var err error
+	if *levels != "" {
+		if filter.Levels, err = parseLevels(*levels); err != nil {
+			return err
+		}
+	}
+	if *types != "" {
+		if filter.Types, err = parseTypes(*types); err != nil {
+			return err
+		}
+	}
+
+	var write func(io.Writer, []*proto.Subject) error
+	switch *format {
+	case "jsonl":
+		write = export.WriteJSONLines
+	case "csv":
+		write = export.WriteCSV
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	s, err := readSubjects()
+	if err != nil {
+		return err
+	}
+	s = filter.Select(s)
+
+	if *output == "" {
+		w := bufio.NewWriter(os.Stdout)
+		if err := write(w, s); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := write(w, s); err != nil {
+		f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command index builds the search index sidecar for a data file.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile/search"
+)
+
+var output = flag.String("output", "", "File to write the index to.  Defaults to the data file with .idx appended")
+
+func run() error {
+	if flag.NArg() != 1 {
+		return errors.New("usage: index [flags] data.bin")
+	}
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	idx, err := search.BuildFromFile(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", flag.Arg(0), err)
+	}
+
+	path := *output
+	if path == "" {
+		path = flag.Arg(0) + ".idx"
+	}
+	if err := idx.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "indexed %d terms from %d subjects\n", len(idx.Terms), len(idx.Subjects))
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command lint checks that a data file, or the stream of Subject messages a
+// data file is built from, is consistent.  It prints every problem it finds,
+// and exits with a non-zero status if there were any.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+)
+
+var (
+	jsonOutput = flag.Bool("json", false, "Print the problems as a JSON list")
+	mmap       = flag.Bool("mmap", false, "Map the file into memory instead of reading it")
+	subjects   = flag.Bool("subjects", false, "Check a stream of length-delimited Subject messages instead of a data file")
+)
+
+func lint(path string) ([]datafile.Problem, error) {
+	if *subjects {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		s, err := datafile.ReadSubjects(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return datafile.LintSubjects(s), nil
+	}
+
+	r, err := datafile.Open(path, datafile.Options{Mmap: *mmap})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return datafile.Lint(r), nil
+}
+
+func run() error {
+	if flag.NArg() != 1 {
+		return errors.New("usage: lint [flags] data.bin, or lint [flags] -subjects subjects.bin")
+	}
+	problems, err := lint(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *jsonOutput {
+		if problems == nil {
+			problems = []datafile.Problem{}
+		}
+		data, err := json.MarshalIndent(problems, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, p := range problems {
+			fmt.Println(p.Error())
+		}
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("%s: %d problem(s)", flag.Arg(0), len(problems))
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command patch applies a DataFileDelta written by the delta command to the
+// data file it was made from.
+//
+//	patch -delta old-to-new.delta -output new.bin old.bin
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var (
+	delta  = flag.String("delta", "", "Delta to apply")
+	output = flag.String("output", "", "File to write the new data file to")
+)
+
+func run() error {
+	if flag.NArg() != 1 || *delta == "" || *output == "" {
+		return errors.New("usage: patch -delta old-to-new.delta -output new.bin old.bin")
+	}
+	base, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(*delta)
+	if err != nil {
+		return err
+	}
+	d := &proto.DataFileDelta{}
+	if err := gproto.Unmarshal(data, d); err != nil {
+		return fmt.Errorf("%s: %w", *delta, err)
+	}
+
+	updated, err := datafile.ApplyDelta(base, d)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*output, updated, 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command roundtrip checks the reader and writer against each other.  It reads
+// every subject from data files, writes them into a new file, and checks that
+// the new file reads back the same and is byte-identical to the original.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+)
+
+var mmap = flag.Bool("mmap", false, "Map the files into memory instead of reading them")
+
+func check(path string) error {
+	r, err := datafile.Open(path, datafile.Options{Mmap: *mmap})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	s, err := r.Subjects()
+	if err != nil {
+		return err
+	}
+	var deleted []int64
+	for _, id := range r.Header.DeletedSubjectIds {
+		deleted = append(deleted, int64(id))
+	}
+
+	w := datafile.NewWriter()
+	if r.Compressed() {
+		w.BlockSize = int(r.Header.Blocks.GetTargetBlockSize())
+	}
+	if err := w.AddAll(s); err != nil {
+		return err
+	}
+	for _, id := range deleted {
+		w.Delete(id)
+	}
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		return err
+	}
+
+	rebuilt, err := datafile.NewReader(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if err := datafile.Verify(rebuilt, s, deleted); err != nil {
+		return err
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(original, buf.Bytes()) {
+		return fmt.Errorf("rebuilt file is %d bytes and differs from the original %d bytes", buf.Len(), len(original))
+	}
+	fmt.Printf("%s: %d subjects, %d deleted: ok\n", path, len(s), len(deleted))
+	return nil
+}
+
+func run() error {
+	if flag.NArg() == 0 {
+		return errors.New("usage: roundtrip [flags] data.bin...")
+	}
+	var errs []error
+	for _, path := range flag.Args() {
+		if err := check(path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command search looks up subjects in a data file's search index sidecar.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile/search"
+)
+
+var (
+	index       = flag.String("index", "", "Index file.  Defaults to the data file with .idx appended")
+	limit       = flag.Int("limit", 20, "Maximum number of results, or 0 for all of them")
+	maxDistance = flag.Int("max_distance", 0, "Largest edit distance for fuzzy matches, 0 to pick one from the query length, or -1 for none")
+	jsonOutput  = flag.Bool("json", false, "Print the results as a JSON list")
+)
+
+type result struct {
+	SubjectID int64   `json:"subject_id"`
+	Type      string  `json:"type"`
+	Japanese  string  `json:"japanese"`
+	Level     int32   `json:"level"`
+	Match     string  `json:"match"`
+	Field     string  `json:"field"`
+	Term      string  `json:"term"`
+	Score     float32 `json:"score"`
+}
+
+func run() error {
+	if flag.NArg() < 2 {
+		return errors.New("usage: search [flags] data.bin query...")
+	}
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	path := *index
+	if path == "" {
+		path = flag.Arg(0) + ".idx"
+	}
+	idx, err := search.Load(path, data)
+	if err != nil {
+		return err
+	}
+
+	results := []result{}
+	for _, r := range idx.Search(strings.Join(flag.Args()[1:], " "), search.Options{
+		Limit:       *limit,
+		MaxDistance: *maxDistance,
+	}) {
+		s := idx.Subjects[r.SubjectID]
+		results = append(results, result{
+			r.SubjectID, s.Type.String(), s.Japanese, s.Level,
+			r.Match.String(), r.Field.String(), r.Term, r.Score,
+		})
+	}
+
+	if *jsonOutput {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	for _, r := range results {
+		fmt.Printf("%d %s %s (level %d): %s %s match on %q, score %.2f\n",
+			r.SubjectID, strings.ToLower(r.Type), r.Japanese, r.Level, r.Match, r.Field, r.Term, r.Score)
+	}
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
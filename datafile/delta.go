@@ -0,0 +1,178 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+var (
+	// ErrWrongBase is returned when a delta is applied to a file other than the
+	// one it was made from.
+	ErrWrongBase = errors.New("delta doesn't apply to this file")
+
+	// ErrWrongResult is returned when applying a delta doesn't give the file it
+	// was made from.
+	ErrWrongResult = errors.New("delta gave the wrong result")
+)
+
+// Checksum is the hex-encoded SHA-256 of a whole data file.
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// MakeDelta returns the changes that turn the base data file into the updated
+// one.  The updated file must have been written by Writer, because ApplyDelta
+// rebuilds it that way, and the delta is applied before it's returned to check
+// that it gives the same bytes.
+func MakeDelta(base, updated []byte) (*proto.DataFileDelta, error) {
+	a, err := NewReader(base)
+	if err != nil {
+		return nil, fmt.Errorf("base: %w", err)
+	}
+	b, err := NewReader(updated)
+	if err != nil {
+		return nil, fmt.Errorf("updated: %w", err)
+	}
+
+	baseSum, resultSum := Checksum(base), Checksum(updated)
+	d := &proto.DataFileDelta{
+		BaseSha256:              &baseSum,
+		ResultSha256:            &resultSum,
+		ResultDeletedSubjectIds: b.Header.DeletedSubjectIds,
+	}
+	if b.Compressed() {
+		d.ResultBlockSize = b.Header.Blocks.TargetBlockSize
+	}
+
+	maxID := a.MaxID()
+	if b.MaxID() > maxID {
+		maxID = b.MaxID()
+	}
+	for id := int64(1); id < maxID; id++ {
+		hasA, hasB := a.Has(id), b.Has(id)
+		switch {
+		case hasA && !hasB:
+			d.DeletedSubjectIds = append(d.DeletedSubjectIds, id)
+			continue
+		case !hasB:
+			continue
+		}
+
+		if hasA {
+			ea, err := a.Encoded(id)
+			if err != nil {
+				return nil, fmt.Errorf("base: %w", err)
+			}
+			eb, err := b.Encoded(id)
+			if err != nil {
+				return nil, fmt.Errorf("updated: %w", err)
+			}
+			if bytes.Equal(ea, eb) {
+				continue
+			}
+		}
+
+		s, err := b.Subject(id)
+		if err != nil {
+			return nil, fmt.Errorf("updated: %w", err)
+		}
+		if hasA {
+			d.ReplacedSubjects = append(d.ReplacedSubjects, s)
+		} else {
+			d.AddedSubjects = append(d.AddedSubjects, s)
+		}
+	}
+
+	if _, err := ApplyDelta(base, d); err != nil {
+		if errors.Is(err, ErrWrongResult) {
+			return nil, fmt.Errorf("%w: the updated file wasn't written by datafile.Writer", err)
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+// ApplyDelta applies a delta to the data file it was made from, and returns
+// the new data file.  The checksums in the delta are checked against both
+// files.
+func ApplyDelta(base []byte, d *proto.DataFileDelta) ([]byte, error) {
+	if sum := Checksum(base); sum != d.GetBaseSha256() {
+		return nil, fmt.Errorf("%w: its checksum is %s, want %s", ErrWrongBase, sum, d.GetBaseSha256())
+	}
+	r, err := NewReader(base)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := map[int64]bool{}
+	for _, id := range d.DeletedSubjectIds {
+		if !r.Has(id) {
+			return nil, fmt.Errorf("deleted subject %d isn't in the base file", id)
+		}
+		changed[id] = true
+	}
+	for _, s := range d.ReplacedSubjects {
+		if !r.Has(s.GetId()) {
+			return nil, fmt.Errorf("replaced subject %d isn't in the base file", s.GetId())
+		}
+		changed[s.GetId()] = true
+	}
+	for _, s := range d.AddedSubjects {
+		if r.Has(s.GetId()) {
+			return nil, fmt.Errorf("added subject %d is already in the base file", s.GetId())
+		}
+	}
+
+	w := NewWriter()
+	w.BlockSize = int(d.GetResultBlockSize())
+	for _, id := range r.IDs() {
+		if changed[id] {
+			continue
+		}
+		s, err := r.Subject(id)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.Add(s); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.AddAll(d.ReplacedSubjects); err != nil {
+		return nil, err
+	}
+	if err := w.AddAll(d.AddedSubjects); err != nil {
+		return nil, err
+	}
+	for _, id := range d.ResultDeletedSubjectIds {
+		w.Delete(int64(id))
+	}
+
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		return nil, err
+	}
+	if sum := Checksum(buf.Bytes()); sum != d.GetResultSha256() {
+		return nil, fmt.Errorf("%w: its checksum is %s, want %s", ErrWrongResult, sum, d.GetResultSha256())
+	}
+	return buf.Bytes(), nil
+}
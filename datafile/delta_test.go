@@ -0,0 +1,221 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func subjectIDs(subjects []*proto.Subject) []int64 {
+	var ret []int64
+	for _, s := range subjects {
+		ret = append(ret, s.GetId())
+	}
+	return ret
+}
+
+// updatedSubjects adds 13, changes 5 and leaves out 9 from testSubjects.
+func updatedSubjects() []*proto.Subject {
+	ret := testSubjects()
+	ret[2].Meanings[0].Meaning = gproto.String("Single")
+	ret[3] = &proto.Subject{
+		Id: gproto.Int64(13), Level: gproto.Int32(3), Japanese: gproto.String("口口"),
+		Vocabulary: &proto.Vocabulary{},
+	}
+	return ret
+}
+
+func TestDelta(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		base         []byte
+		updated      []byte
+		added        []int64
+		replaced     []int64
+		deleted      []int64
+		resultDelete []int32
+	}{
+		{
+			name:     "added, changed and deleted",
+			base:     writeFile(t, 0, testSubjects()),
+			updated:  writeFile(t, 0, updatedSubjects()),
+			added:    []int64{13},
+			replaced: []int64{5},
+			deleted:  []int64{9},
+		},
+		{
+			name:         "deleted in the header",
+			base:         writeFile(t, 0, testSubjects()),
+			updated:      writeFile(t, 0, updatedSubjects(), 9),
+			added:        []int64{13},
+			replaced:     []int64{5},
+			deleted:      []int64{9},
+			resultDelete: []int32{9},
+		},
+		{
+			name:     "compressed",
+			base:     writeFile(t, 64, testSubjects()),
+			updated:  writeFile(t, 32, updatedSubjects()),
+			added:    []int64{13},
+			replaced: []int64{5},
+			deleted:  []int64{9},
+		},
+		{
+			name:         "header only",
+			base:         writeFile(t, 0, testSubjects()),
+			updated:      writeFile(t, 0, testSubjects(), 20),
+			resultDelete: []int32{20},
+		},
+		{
+			name:    "compression only",
+			base:    writeFile(t, 0, testSubjects()),
+			updated: writeFile(t, 64, testSubjects()),
+		},
+		{
+			name:    "same file",
+			base:    writeFile(t, 0, testSubjects()),
+			updated: writeFile(t, 0, testSubjects()),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := MakeDelta(tc.base, tc.updated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := subjectIDs(d.AddedSubjects); !reflect.DeepEqual(got, tc.added) {
+				t.Errorf("added %v, want %v", got, tc.added)
+			}
+			if got := subjectIDs(d.ReplacedSubjects); !reflect.DeepEqual(got, tc.replaced) {
+				t.Errorf("replaced %v, want %v", got, tc.replaced)
+			}
+			if !reflect.DeepEqual(d.DeletedSubjectIds, tc.deleted) {
+				t.Errorf("deleted %v, want %v", d.DeletedSubjectIds, tc.deleted)
+			}
+			if !reflect.DeepEqual(d.ResultDeletedSubjectIds, tc.resultDelete) {
+				t.Errorf("result deleted %v, want %v", d.ResultDeletedSubjectIds, tc.resultDelete)
+			}
+
+			// Send the delta through its encoding, as the patch command does.
+			b, err := gproto.Marshal(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := &proto.DataFileDelta{}
+			if err := gproto.Unmarshal(b, decoded); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ApplyDelta(tc.base, decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tc.updated) {
+				t.Errorf("ApplyDelta didn't give the updated file")
+			}
+		})
+	}
+}
+
+func TestMakeDeltaNotFromWriter(t *testing.T) {
+	base := writeFile(t, 0, testSubjects())
+	// The writer never adds a level with no subjects.
+	r, err := NewReader(writeFile(t, 0, updatedSubjects()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.SubjectsByLevel = append(r.Header.SubjectsByLevel, &proto.SubjectsByLevel{})
+	var updated bytes.Buffer
+	if err := Encode(&updated, r.Header, r.payload); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MakeDelta(base, updated.Bytes()); !errors.Is(err, ErrWrongResult) {
+		t.Errorf("got %v, want ErrWrongResult", err)
+	}
+}
+
+func TestApplyDeltaWrongBase(t *testing.T) {
+	base := writeFile(t, 0, testSubjects())
+	d, err := MakeDelta(base, writeFile(t, 0, updatedSubjects()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, other := range [][]byte{
+		writeFile(t, 0, updatedSubjects()),
+		writeFile(t, 64, testSubjects()),
+		base[:len(base)-1],
+		nil,
+	} {
+		if _, err := ApplyDelta(other, d); !errors.Is(err, ErrWrongBase) {
+			t.Errorf("got %v, want ErrWrongBase", err)
+		}
+	}
+}
+
+func TestApplyDeltaCorrupted(t *testing.T) {
+	base := writeFile(t, 0, testSubjects())
+	d, err := MakeDelta(base, writeFile(t, 0, updatedSubjects()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		corrupt func(d *proto.DataFileDelta)
+	}{
+		{"changed subject", func(d *proto.DataFileDelta) { d.ReplacedSubjects[0].Japanese = gproto.String("二") }},
+		{"missing subject", func(d *proto.DataFileDelta) { d.AddedSubjects = nil }},
+		{"missing deletion", func(d *proto.DataFileDelta) { d.DeletedSubjectIds = nil }},
+		{"replaced isn't in the base", func(d *proto.DataFileDelta) { d.ReplacedSubjects[0].Id = gproto.Int64(13) }},
+		{"added is in the base", func(d *proto.DataFileDelta) { d.AddedSubjects[0].Id = gproto.Int64(5) }},
+		{"deleted isn't in the base", func(d *proto.DataFileDelta) { d.DeletedSubjectIds = []int64{20} }},
+		{"wrong result checksum", func(d *proto.DataFileDelta) { d.ResultSha256 = gproto.String(Checksum(nil)) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			corrupted := gproto.Clone(d).(*proto.DataFileDelta)
+			tc.corrupt(corrupted)
+			if _, err := ApplyDelta(base, corrupted); err == nil {
+				t.Errorf("no error")
+			}
+		})
+	}
+}
+
+func TestApplyDeltaTruncated(t *testing.T) {
+	base := writeFile(t, 0, testSubjects())
+	d, err := MakeDelta(base, writeFile(t, 0, updatedSubjects(), 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := gproto.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every prefix either fails to decode or is rejected by ApplyDelta.
+	for i := 0; i < len(b); i++ {
+		truncated := &proto.DataFileDelta{}
+		if err := gproto.Unmarshal(b[:i], truncated); err != nil {
+			continue
+		}
+		if _, err := ApplyDelta(base, truncated); err == nil {
+			t.Errorf("delta truncated to %d of %d bytes was applied", i, len(b))
+		}
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SubjectSummary identifies an added or deleted subject.
+type SubjectSummary struct {
+	SubjectID int64  `json:"subject_id"`
+	Type      string `json:"type"`
+	Japanese  string `json:"japanese"`
+	Level     int32  `json:"level"`
+}
+
+func summarize(s *proto.Subject) SubjectSummary {
+	return SubjectSummary{s.GetId(), TypeOf(s).String(), s.GetJapanese(), s.GetLevel()}
+}
+
+// FieldChange is one field that differs between two versions of a subject.
+// Old is missing for fields that were added, and New for fields that were
+// removed.  Values are in the protobuf JSON format.
+type FieldChange struct {
+	Path string          `json:"path"`
+	Old  json.RawMessage `json:"old,omitempty"`
+	New  json.RawMessage `json:"new,omitempty"`
+}
+
+// SubjectDiff is a subject that is in both files but changed.
+type SubjectDiff struct {
+	SubjectSummary
+
+	// OldLevel is set if the subject moved to another level.
+	OldLevel int32 `json:"old_level,omitempty"`
+
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FileDiff is the difference between two data files.
+type FileDiff struct {
+	Added   []SubjectSummary `json:"added"`
+	Deleted []SubjectSummary `json:"deleted"`
+	Changed []SubjectDiff    `json:"changed"`
+}
+
+// DiffFiles compares every subject in two data files.
+func DiffFiles(old, updated *Reader) (*FileDiff, error) {
+	d := &FileDiff{
+		Added:   []SubjectSummary{},
+		Deleted: []SubjectSummary{},
+		Changed: []SubjectDiff{},
+	}
+
+	ids := map[int64]bool{}
+	for _, id := range old.IDs() {
+		ids[id] = true
+	}
+	for _, id := range updated.IDs() {
+		ids[id] = true
+	}
+	var sorted []int64
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, id := range sorted {
+		var a, b *proto.Subject
+		var err error
+		if old.Has(id) {
+			if a, err = old.Subject(id); err != nil {
+				return nil, err
+			}
+		}
+		if updated.Has(id) {
+			if b, err = updated.Subject(id); err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case a == nil:
+			d.Added = append(d.Added, summarize(b))
+		case b == nil:
+			d.Deleted = append(d.Deleted, summarize(a))
+		default:
+			sd, ok, err := DiffSubjects(a, b)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				d.Changed = append(d.Changed, sd)
+			}
+		}
+	}
+	return d, nil
+}
+
+// DiffSubjects compares two versions of a subject field by field, and returns
+// false if they're the same.  Elements of repeated messages whose first field
+// is a string, like meanings, readings and sentences, are matched by that
+// field's value.  Other repeated fields are compared by position.
+func DiffSubjects(old, updated *proto.Subject) (SubjectDiff, bool, error) {
+	d := SubjectDiff{SubjectSummary: summarize(updated)}
+	if old.GetLevel() != updated.GetLevel() {
+		d.OldLevel = old.GetLevel()
+	}
+
+	// The ID and level are reported above.
+	skip := map[protoreflect.Name]bool{"id": true, "level": true}
+	if err := diffMessage("", old.ProtoReflect(), updated.ProtoReflect(), skip, &d.Fields); err != nil {
+		return d, false, fmt.Errorf("subject %d: %w", updated.GetId(), err)
+	}
+	return d, d.OldLevel != 0 || len(d.Fields) != 0, nil
+}
+
+func joinPath(path string, name protoreflect.Name) string {
+	if path == "" {
+		return string(name)
+	}
+	return path + "." + string(name)
+}
+
+func diffMessage(path string, a, b protoreflect.Message, skip map[protoreflect.Name]bool, out *[]FieldChange) error {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if skip[fd.Name()] {
+			continue
+		}
+		p := joinPath(path, fd.Name())
+		hasA, hasB := a.Has(fd), b.Has(fd)
+
+		var err error
+		switch {
+		case !hasA && !hasB:
+		case fd.IsList():
+			err = diffList(p, fd, a.Get(fd).List(), b.Get(fd).List(), out)
+		case fd.Message() != nil && hasA && hasB:
+			err = diffMessage(p, a.Get(fd).Message(), b.Get(fd).Message(), nil, out)
+		case !hasA || !hasB || !a.Get(fd).Equal(b.Get(fd)):
+			c := FieldChange{Path: p}
+			if hasA {
+				if c.Old, err = fieldJSON(fd, a.Get(fd)); err != nil {
+					break
+				}
+			}
+			if hasB {
+				if c.New, err = fieldJSON(fd, b.Get(fd)); err != nil {
+					break
+				}
+			}
+			*out = append(*out, c)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listKey returns the field that elements of a repeated message are matched
+// by, or nil if they're matched by position.
+func listKey(fd protoreflect.FieldDescriptor, lists ...protoreflect.List) protoreflect.FieldDescriptor {
+	if fd.Message() == nil || fd.Message().Fields().Len() == 0 {
+		return nil
+	}
+	key := fd.Message().Fields().Get(0)
+	if key.Kind() != protoreflect.StringKind || key.IsList() {
+		return nil
+	}
+	for _, l := range lists {
+		seen := map[string]bool{}
+		for i := 0; i < l.Len(); i++ {
+			k := l.Get(i).Message().Get(key).String()
+			if seen[k] {
+				return nil
+			}
+			seen[k] = true
+		}
+	}
+	return key
+}
+
+func diffList(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.List, out *[]FieldChange) error {
+	if fd.Message() == nil {
+		if listEqual(a, b) {
+			return nil
+		}
+		old, err := listJSON(fd, a)
+		if err != nil {
+			return err
+		}
+		updated, err := listJSON(fd, b)
+		if err != nil {
+			return err
+		}
+		*out = append(*out, FieldChange{path, old, updated})
+		return nil
+	}
+
+	// added and removed record a whole element that is only in one list.
+	added := func(p string, v protoreflect.Value) error {
+		j, err := valueJSON(fd, v)
+		if err == nil {
+			*out = append(*out, FieldChange{Path: p, New: j})
+		}
+		return err
+	}
+	removed := func(p string, v protoreflect.Value) error {
+		j, err := valueJSON(fd, v)
+		if err == nil {
+			*out = append(*out, FieldChange{Path: p, Old: j})
+		}
+		return err
+	}
+
+	key := listKey(fd, a, b)
+	if key == nil {
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			var err error
+			switch {
+			case i >= b.Len():
+				err = removed(p, a.Get(i))
+			case i >= a.Len():
+				err = added(p, b.Get(i))
+			default:
+				err = diffMessage(p, a.Get(i).Message(), b.Get(i).Message(), nil, out)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	byKey := map[string]protoreflect.Message{}
+	for i := 0; i < b.Len(); i++ {
+		m := b.Get(i).Message()
+		byKey[m.Get(key).String()] = m
+	}
+	matched := map[string]bool{}
+	for i := 0; i < a.Len(); i++ {
+		m := a.Get(i).Message()
+		k := m.Get(key).String()
+		p := fmt.Sprintf("%s[%q]", path, k)
+		other, ok := byKey[k]
+		if !ok {
+			if err := removed(p, a.Get(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		matched[k] = true
+		if err := diffMessage(p, m, other, nil, out); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < b.Len(); i++ {
+		k := b.Get(i).Message().Get(key).String()
+		if !matched[k] {
+			if err := added(fmt.Sprintf("%s[%q]", path, k), b.Get(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func listEqual(a, b protoreflect.List) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if !a.Get(i).Equal(b.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) (json.RawMessage, error) {
+	if fd.IsList() {
+		return listJSON(fd, v.List())
+	}
+	return valueJSON(fd, v)
+}
+
+func listJSON(fd protoreflect.FieldDescriptor, l protoreflect.List) (json.RawMessage, error) {
+	var parts [][]byte
+	for i := 0; i < l.Len(); i++ {
+		b, err := valueJSON(fd, l.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, b)
+	}
+	return json.RawMessage("[" + string(bytes.Join(parts, []byte(","))) + "]"), nil
+}
+
+// valueJSON formats a single value of a field.  Enums are written by name, and
+// messages in the protobuf JSON format.
+func valueJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) (json.RawMessage, error) {
+	var x interface{}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		b, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.FullName(), err)
+		}
+		// protojson randomly adds whitespace, so remove it.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.FullName(), err)
+		}
+		return buf.Bytes(), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			x = string(ev.Name())
+		} else {
+			x = int32(v.Enum())
+		}
+	default:
+		x = v.Interface()
+	}
+	b, err := json.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fd.FullName(), err)
+	}
+	return b, nil
+}
+
+// WriteFileDiff writes a human-readable summary of a FileDiff.
+func WriteFileDiff(w io.Writer, d *FileDiff) {
+	name := func(s SubjectSummary) string {
+		return fmt.Sprintf("%d %s %s", s.SubjectID, strings.ToLower(s.Type), s.Japanese)
+	}
+	for _, s := range d.Added {
+		fmt.Fprintf(w, "+ %s (level %d)\n", name(s), s.Level)
+	}
+	for _, s := range d.Deleted {
+		fmt.Fprintf(w, "- %s (level %d)\n", name(s), s.Level)
+	}
+	for _, s := range d.Changed {
+		if s.OldLevel != 0 {
+			fmt.Fprintf(w, "~ %s: level %d -> %d\n", name(s.SubjectSummary), s.OldLevel, s.Level)
+		} else {
+			fmt.Fprintf(w, "~ %s\n", name(s.SubjectSummary))
+		}
+		for _, f := range s.Fields {
+			switch {
+			case f.Old == nil:
+				fmt.Fprintf(w, "    %s: added %s\n", f.Path, f.New)
+			case f.New == nil:
+				fmt.Fprintf(w, "    %s: removed %s\n", f.Path, f.Old)
+			default:
+				fmt.Fprintf(w, "    %s: %s -> %s\n", f.Path, f.Old, f.New)
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d added, %d deleted, %d changed\n", len(d.Added), len(d.Deleted), len(d.Changed))
+}
@@ -0,0 +1,206 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func TestDiffFiles(t *testing.T) {
+	old := testSubjects()
+
+	updated := testSubjects()
+	// 1 is unchanged, 2 is deleted in the header, 5 moves level and changes,
+	// 9 disappears, 12 is unchanged and 13 is added.
+	updated[2].Level = gproto.Int32(2)
+	updated[2].Meanings[0].Meaning = gproto.String("Single")
+	updated[3] = &proto.Subject{
+		Id: gproto.Int64(13), Level: gproto.Int32(3), Japanese: gproto.String("口口"),
+		Vocabulary: &proto.Vocabulary{},
+	}
+	updated = append(updated[:1], updated[2:]...)
+
+	for _, tc := range []struct {
+		name               string
+		oldBlock, newBlock int
+	}{
+		{"uncompressed", 0, 0},
+		{"compression isn't a difference", 0, 64},
+		{"both compressed", 64, 64},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d, err := DiffFiles(roundTrip(t, tc.oldBlock, old), roundTrip(t, tc.newBlock, updated, 2))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := `{"added":[{"subject_id":13,"type":"VOCABULARY","japanese":"口口","level":3}],` +
+				`"deleted":[{"subject_id":2,"type":"RADICAL","japanese":"口","level":1},{"subject_id":9,"type":"VOCABULARY","japanese":"一つ","level":2}],` +
+				`"changed":[{"subject_id":5,"type":"KANJI","japanese":"一","level":2,"old_level":1,"fields":[` +
+				`{"path":"meanings[\"One\"]","old":{"meaning":"One","type":"PRIMARY"}},` +
+				`{"path":"meanings[\"Single\"]","new":{"meaning":"Single","type":"PRIMARY"}}]}]}`
+			if string(got) != want {
+				t.Errorf("got  %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffFilesSame(t *testing.T) {
+	d, err := DiffFiles(roundTrip(t, 0, testSubjects()), roundTrip(t, 64, testSubjects()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"added":[],"deleted":[],"changed":[]}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestDiffSubjects(t *testing.T) {
+	base := func() *proto.Subject {
+		return &proto.Subject{
+			Id: gproto.Int64(5), Level: gproto.Int32(1), Japanese: gproto.String("一"),
+			Meanings: []*proto.Meaning{
+				{Meaning: gproto.String("One"), Type: proto.Meaning_PRIMARY.Enum()},
+				{Meaning: gproto.String("Single"), Type: proto.Meaning_SECONDARY.Enum()},
+			},
+			Readings: []*proto.Reading{
+				{Reading: gproto.String("いち"), IsPrimary: gproto.Bool(true)},
+			},
+			ComponentSubjectIds: []int64{1},
+			Kanji:               &proto.Kanji{MeaningMnemonic: gproto.String("One mnemonic")},
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		change func(s *proto.Subject)
+		want   string
+	}{
+		{
+			name:   "unchanged",
+			change: func(s *proto.Subject) {},
+		},
+		{
+			name:   "level",
+			change: func(s *proto.Subject) { s.Level = gproto.Int32(3) },
+			want:   `"old_level":1`,
+		},
+		{
+			name:   "meaning type",
+			change: func(s *proto.Subject) { s.Meanings[1].Type = proto.Meaning_AUXILIARY_WHITELIST.Enum() },
+			want:   `"fields":[{"path":"meanings[\"Single\"].type","old":"SECONDARY","new":"AUXILIARY_WHITELIST"}]`,
+		},
+		{
+			name:   "reordered meanings",
+			change: func(s *proto.Subject) { s.Meanings[0], s.Meanings[1] = s.Meanings[1], s.Meanings[0] },
+		},
+		{
+			name: "added reading",
+			change: func(s *proto.Subject) {
+				s.Readings = append(s.Readings, &proto.Reading{Reading: gproto.String("いつ")})
+			},
+			want: `"fields":[{"path":"readings[\"いつ\"]","new":{"reading":"いつ"}}]`,
+		},
+		{
+			name:   "removed readings",
+			change: func(s *proto.Subject) { s.Readings = nil },
+			want:   `"fields":[{"path":"readings[\"いち\"]","old":{"reading":"いち","isPrimary":true}}]`,
+		},
+		{
+			name:   "components",
+			change: func(s *proto.Subject) { s.ComponentSubjectIds = []int64{1, 2} },
+			want:   `"fields":[{"path":"component_subject_ids","old":[1],"new":[1,2]}]`,
+		},
+		{
+			name:   "mnemonic",
+			change: func(s *proto.Subject) { s.Kanji.MeaningMnemonic = gproto.String("Another mnemonic") },
+			want:   `"fields":[{"path":"kanji.meaning_mnemonic","old":"One mnemonic","new":"Another mnemonic"}]`,
+		},
+		{
+			name:   "cleared field",
+			change: func(s *proto.Subject) { s.Japanese = nil },
+			want:   `"fields":[{"path":"japanese","old":"一"}]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := base()
+			tc.change(updated)
+			d, ok, err := DiffSubjects(base(), updated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != (tc.want != "") {
+				t.Fatalf("changed = %v, want %v: %+v", ok, tc.want != "", d)
+			}
+			if !ok {
+				return
+			}
+			got, err := json.Marshal(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Contains(got, []byte(tc.want)) {
+				t.Errorf("got %s, want it to contain %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteFileDiff(t *testing.T) {
+	d := &FileDiff{
+		Added:   []SubjectSummary{{13, "VOCABULARY", "口口", 3}},
+		Deleted: []SubjectSummary{{2, "RADICAL", "口", 1}},
+		Changed: []SubjectDiff{
+			{
+				SubjectSummary: SubjectSummary{5, "KANJI", "一", 2},
+				OldLevel:       1,
+				Fields: []FieldChange{
+					{Path: "japanese", Old: json.RawMessage(`"一"`), New: json.RawMessage(`"二"`)},
+					{Path: "readings[\"いつ\"]", New: json.RawMessage(`{"reading":"いつ"}`)},
+					{Path: "readings[\"いち\"]", Old: json.RawMessage(`{"reading":"いち"}`)},
+				},
+			},
+			{SubjectSummary: SubjectSummary{9, "VOCABULARY", "一つ", 2}, Fields: []FieldChange{{Path: "slug", New: json.RawMessage(`"x"`)}}},
+		},
+	}
+	var buf bytes.Buffer
+	WriteFileDiff(&buf, d)
+	want := `+ 13 vocabulary 口口 (level 3)
+- 2 radical 口 (level 1)
+~ 5 kanji 一: level 1 -> 2
+    japanese: "一" -> "二"
+    readings["いつ"]: added {"reading":"いつ"}
+    readings["いち"]: removed {"reading":"いち"}
+~ 9 vocabulary 一つ
+    slug: added "x"
+1 added, 1 deleted, 2 changed
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export writes subjects as JSON Lines or as CSV with one row per
+// subject, for loading into spreadsheets and notebooks.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Columns is the CSV header.  Columns are only ever added to the end, so
+// scripts can rely on their positions.  Columns with more than one value have
+// them separated by Separator.
+var Columns = []string{
+	"id",
+	"type",
+	"level",
+	"slug",
+	"japanese",
+	"document_url",
+	"primary_meanings",
+	"secondary_meanings",
+	"whitelisted_meanings",
+	"blacklisted_meanings",
+	"primary_readings",
+	"onyomi_readings",
+	"kunyomi_readings",
+	"nanori_readings",
+	"other_readings",
+	"parts_of_speech",
+	"component_subject_ids",
+	"amalgamation_subject_ids",
+	"sentences_japanese",
+	"sentences_english",
+}
+
+// Separator goes between the values in a multi-valued column.  It's a
+// newline because meanings and sentences can contain commas and semicolons.
+const Separator = "\n"
+
+// Filter selects subjects.  An empty filter selects everything.
+type Filter struct {
+	Levels map[int32]bool
+	Types  map[proto.Subject_Type]bool
+}
+
+// Match returns whether the filter selects a subject.
+func (f Filter) Match(s *proto.Subject) bool {
+	if len(f.Levels) != 0 && !f.Levels[s.GetLevel()] {
+		return false
+	}
+	if len(f.Types) != 0 && !f.Types[datafile.TypeOf(s)] {
+		return false
+	}
+	return true
+}
+
+// Select returns the subjects the filter matches, sorted by ID.
+func (f Filter) Select(subjects []*proto.Subject) []*proto.Subject {
+	var ret []*proto.Subject
+	for _, s := range subjects {
+		if f.Match(s) {
+			ret = append(ret, s)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].GetId() < ret[j].GetId() })
+	return ret
+}
+
+func clean(s string) string {
+	// Newlines would be confused with Separator.
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func join(values []string) string {
+	return strings.Join(values, Separator)
+}
+
+func joinIDs(ids []int64) string {
+	var s []string
+	for _, id := range ids {
+		s = append(s, strconv.FormatInt(id, 10))
+	}
+	return join(s)
+}
+
+// Row flattens a subject into values for Columns.
+func Row(s *proto.Subject) []string {
+	meanings := map[proto.Meaning_Type][]string{}
+	for _, m := range s.Meanings {
+		t := m.GetType()
+		if t == proto.Meaning_UNKNOWN {
+			t = proto.Meaning_SECONDARY
+		}
+		meanings[t] = append(meanings[t], clean(m.GetMeaning()))
+	}
+
+	var primary []string
+	readings := map[proto.Reading_Type][]string{}
+	for _, r := range s.Readings {
+		if r.GetIsPrimary() {
+			primary = append(primary, clean(r.GetReading()))
+		}
+		readings[r.GetType()] = append(readings[r.GetType()], clean(r.GetReading()))
+	}
+
+	var pos, sentencesJA, sentencesEN []string
+	for _, p := range s.GetVocabulary().GetPartsOfSpeech() {
+		pos = append(pos, strings.ToLower(p.String()))
+	}
+	for _, st := range s.GetVocabulary().GetSentences() {
+		sentencesJA = append(sentencesJA, clean(st.GetJapanese()))
+		sentencesEN = append(sentencesEN, clean(st.GetEnglish()))
+	}
+
+	return []string{
+		strconv.FormatInt(s.GetId(), 10),
+		strings.ToLower(datafile.TypeOf(s).String()),
+		strconv.Itoa(int(s.GetLevel())),
+		s.GetSlug(),
+		s.GetJapanese(),
+		s.GetDocumentUrl(),
+		join(meanings[proto.Meaning_PRIMARY]),
+		join(meanings[proto.Meaning_SECONDARY]),
+		join(meanings[proto.Meaning_AUXILIARY_WHITELIST]),
+		join(meanings[proto.Meaning_BLACKLIST]),
+		join(primary),
+		join(readings[proto.Reading_ONYOMI]),
+		join(readings[proto.Reading_KUNYOMI]),
+		join(readings[proto.Reading_NANORI]),
+		join(readings[proto.Reading_UNKNOWN]),
+		join(pos),
+		joinIDs(s.ComponentSubjectIds),
+		joinIDs(s.AmalgamationSubjectIds),
+		join(sentencesJA),
+		join(sentencesEN),
+	}
+}
+
+// WriteCSV writes a header and one row for each subject.
+func WriteCSV(w io.Writer, subjects []*proto.Subject) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Columns); err != nil {
+		return err
+	}
+	for _, s := range subjects {
+		if err := cw.Write(Row(s)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSONLines writes each subject on its own line in the protobuf JSON
+// format.
+func WriteJSONLines(w io.Writer, subjects []*proto.Subject) error {
+	for _, s := range subjects {
+		b, err := protojson.Marshal(s)
+		if err != nil {
+			return err
+		}
+		// protojson randomly adds whitespace, so remove it.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
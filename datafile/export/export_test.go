@@ -0,0 +1,135 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func testSubjects() []*proto.Subject {
+	return []*proto.Subject{
+		{
+			Id: gproto.Int64(2467), Level: gproto.Int32(1), Slug: gproto.String("一つ"), Japanese: gproto.String("一つ"),
+			DocumentUrl: gproto.String("https://www.wanikani.com/vocabulary/一つ"),
+			Meanings: []*proto.Meaning{
+				{Meaning: gproto.String("One Thing"), Type: proto.Meaning_PRIMARY.Enum()},
+				{Meaning: gproto.String("One Item"), Type: proto.Meaning_AUXILIARY_WHITELIST.Enum()},
+			},
+			Readings:            []*proto.Reading{{Reading: gproto.String("ひとつ"), IsPrimary: gproto.Bool(true)}},
+			ComponentSubjectIds: []int64{440},
+			Vocabulary: &proto.Vocabulary{
+				PartsOfSpeech: []proto.Vocabulary_PartOfSpeech{proto.Vocabulary_NUMERAL, proto.Vocabulary_NOUN},
+				Sentences: []*proto.Vocabulary_Sentence{
+					{Japanese: gproto.String("りんごを一つください。"), English: gproto.String("One apple, please.")},
+					{Japanese: gproto.String("一つ、\n二つ"), English: gproto.String(`One, "two"`)},
+				},
+			},
+		},
+		{
+			Id: gproto.Int64(440), Level: gproto.Int32(1), Slug: gproto.String("一"), Japanese: gproto.String("一"),
+			Meanings: []*proto.Meaning{
+				{Meaning: gproto.String("One"), Type: proto.Meaning_PRIMARY.Enum()},
+				{Meaning: gproto.String("Ground"), Type: proto.Meaning_BLACKLIST.Enum()},
+				{Meaning: gproto.String("Single"), Type: proto.Meaning_UNKNOWN.Enum()},
+			},
+			Readings: []*proto.Reading{
+				{Reading: gproto.String("いち"), IsPrimary: gproto.Bool(true), Type: proto.Reading_ONYOMI.Enum()},
+				{Reading: gproto.String("ひと"), Type: proto.Reading_KUNYOMI.Enum()},
+				{Reading: gproto.String("かず"), Type: proto.Reading_NANORI.Enum()},
+			},
+			AmalgamationSubjectIds: []int64{2467, 2468},
+			Kanji:                  &proto.Kanji{MeaningMnemonic: gproto.String("Lying on the ground.")},
+		},
+		{
+			Id: gproto.Int64(1), Level: gproto.Int32(2), Japanese: gproto.String("一"),
+			Radical: &proto.Radical{},
+		},
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, Filter{}.Select(testSubjects())); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join(Columns, ",") + "\n" +
+		"1,radical,2,,一,,,,,,,,,,,,,,,\n" +
+		"440,kanji,1,一,一,,One,Single,,Ground,いち,いち,ひと,かず,,,,\"2467\n2468\",,\n" +
+		"2467,vocabulary,1,一つ,一つ,https://www.wanikani.com/vocabulary/一つ,One Thing,,One Item,,ひとつ,,,,ひとつ,\"numeral\nnoun\",440,," +
+		"\"りんごを一つください。\n一つ、 二つ\",\"One apple, please.\nOne, \"\"two\"\"\"\n"
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	// Scripts rely on the positions of the columns, so they must never move.
+	want := []string{
+		"id", "type", "level", "slug", "japanese", "document_url",
+		"primary_meanings", "secondary_meanings", "whitelisted_meanings", "blacklisted_meanings",
+		"primary_readings", "onyomi_readings", "kunyomi_readings", "nanori_readings", "other_readings",
+		"parts_of_speech", "component_subject_ids", "amalgamation_subject_ids",
+		"sentences_japanese", "sentences_english",
+	}
+	if !reflect.DeepEqual(Columns[:len(want)], want) {
+		t.Errorf("got %q, want it to start with %q", Columns, want)
+	}
+	for _, s := range testSubjects() {
+		if got := len(Row(s)); got != len(Columns) {
+			t.Errorf("subject %d has %d values for %d columns", s.GetId(), got, len(Columns))
+		}
+	}
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSONLines(&buf, Filter{}.Select(testSubjects())); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":"1","level":2,"japanese":"一","radical":{}}
+{"id":"440","level":1,"slug":"一","japanese":"一","readings":[{"reading":"いち","isPrimary":true,"type":"ONYOMI"},{"reading":"ひと","type":"KUNYOMI"},{"reading":"かず","type":"NANORI"}],"meanings":[{"meaning":"One","type":"PRIMARY"},{"meaning":"Ground","type":"BLACKLIST"},{"meaning":"Single","type":"UNKNOWN"}],"amalgamationSubjectIds":["2467","2468"],"kanji":{"meaningMnemonic":"Lying on the ground."}}
+{"id":"2467","level":1,"slug":"一つ","documentUrl":"https://www.wanikani.com/vocabulary/一つ","japanese":"一つ","readings":[{"reading":"ひとつ","isPrimary":true}],"meanings":[{"meaning":"One Thing","type":"PRIMARY"},{"meaning":"One Item","type":"AUXILIARY_WHITELIST"}],"componentSubjectIds":["440"],"vocabulary":{"sentences":[{"japanese":"りんごを一つください。","english":"One apple, please."},{"japanese":"一つ、\n二つ","english":"One, \"two\""}],"partsOfSpeech":["NUMERAL","NOUN"]}}
+`
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		filter Filter
+		want   []int64
+	}{
+		{"everything", Filter{}, []int64{1, 440, 2467}},
+		{"level", Filter{Levels: map[int32]bool{1: true}}, []int64{440, 2467}},
+		{"type", Filter{Types: map[proto.Subject_Type]bool{proto.Subject_RADICAL: true, proto.Subject_KANJI: true}}, []int64{1, 440}},
+		{"level and type", Filter{Levels: map[int32]bool{2: true}, Types: map[proto.Subject_Type]bool{proto.Subject_KANJI: true}}, nil},
+	} {
+		var got []int64
+		for _, s := range tc.filter.Select(testSubjects()) {
+			got = append(got, s.GetId())
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+// The checks done by Lint and LintSubjects.
+const (
+	CheckOffsets    = "offsets"
+	CheckDecode     = "decode"
+	CheckReferences = "references"
+	CheckReadings   = "radical_readings"
+	CheckType       = "type"
+	CheckLevel      = "level"
+	CheckDeleted    = "deleted"
+	CheckIDs        = "ids"
+)
+
+// Problem is something wrong with a data file or a set of subjects, found by
+// Lint or LintSubjects.
+type Problem struct {
+	Check string `json:"check"`
+
+	// SubjectID is the subject with the problem, or 0 if the problem is with
+	// the file as a whole.
+	SubjectID int64  `json:"subject_id,omitempty"`
+	Message   string `json:"message"`
+}
+
+func (p Problem) Error() string {
+	if p.SubjectID == 0 {
+		return fmt.Sprintf("%s: %s", p.Check, p.Message)
+	}
+	return fmt.Sprintf("subject %d: %s: %s", p.SubjectID, p.Check, p.Message)
+}
+
+type listing struct {
+	level int
+	t     proto.Subject_Type
+}
+
+// linter collects problems.
+type linter struct {
+	problems []Problem
+}
+
+func (l *linter) add(check string, id int64, format string, args ...interface{}) {
+	l.problems = append(l.problems, Problem{check, id, fmt.Sprintf(format, args...)})
+}
+
+// sorted returns the problems ordered by subject ID.
+func (l *linter) sorted() []Problem {
+	sort.SliceStable(l.problems, func(i, j int) bool {
+		a, b := l.problems[i], l.problems[j]
+		if a.SubjectID != b.SubjectID {
+			return a.SubjectID < b.SubjectID
+		}
+		if a.Check != b.Check {
+			return a.Check < b.Check
+		}
+		return a.Message < b.Message
+	})
+	return l.problems
+}
+
+func sortedIDs[T any](m map[int64]T) []int64 {
+	ids := make([]int64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// LintSubjects checks a set of subjects, like the stream a data file is built
+// from:
+//   - every subject has an ID that no other subject has,
+//   - every subject has a level and a radical, kanji or vocabulary message,
+//   - radicals have no readings,
+//   - component and amalgamation IDs exist and list each other back.
+//
+// Problems are ordered by subject ID.
+func LintSubjects(subjects []*proto.Subject) []Problem {
+	l := &linter{}
+	l.subjects(subjects)
+	return l.sorted()
+}
+
+func (l *linter) subjects(subjects []*proto.Subject) {
+	byID := map[int64]*proto.Subject{}
+	for i, s := range subjects {
+		id := s.GetId()
+		if id <= 0 {
+			l.add(CheckIDs, 0, "subject %d in the list (%q) has no ID", i, s.GetJapanese())
+			continue
+		}
+		if _, ok := byID[id]; ok {
+			l.add(CheckIDs, id, "listed more than once")
+			continue
+		}
+		byID[id] = s
+	}
+
+	for _, id := range sortedIDs(byID) {
+		s := byID[id]
+		t := TypeOf(s)
+		if t == proto.Subject_UNKNOWN {
+			l.add(CheckType, id, "has no radical, kanji or vocabulary")
+		}
+		if s.GetLevel() <= 0 {
+			l.add(CheckLevel, id, "has no level")
+		}
+
+		if t == proto.Subject_RADICAL && len(s.Readings) != 0 {
+			l.add(CheckReadings, id, "radical has %d reading(s)", len(s.Readings))
+		}
+
+		for _, other := range s.ComponentSubjectIds {
+			o, ok := byID[other]
+			if !ok {
+				l.add(CheckReferences, id, "component %d doesn't exist", other)
+			} else if !contains(o.AmalgamationSubjectIds, id) {
+				l.add(CheckReferences, id, "component %d doesn't list it as an amalgamation", other)
+			}
+		}
+		for _, other := range s.AmalgamationSubjectIds {
+			o, ok := byID[other]
+			if !ok {
+				l.add(CheckReferences, id, "amalgamation %d doesn't exist", other)
+			} else if !contains(o.ComponentSubjectIds, id) {
+				l.add(CheckReferences, id, "amalgamation %d doesn't list it as a component", other)
+			}
+		}
+	}
+}
+
+// Lint checks that a data file is consistent:
+//   - subject offsets never go backwards and stay inside the file,
+//   - every subject decodes and has the ID it's stored under,
+//   - the subjects pass LintSubjects,
+//   - subjects are listed in subjects_by_level under the type they have, so
+//     kanji have a Kanji message and so on,
+//   - every subject's level matches level_by_subject and subjects_by_level,
+//   - no ID is both deleted and live.
+//
+// Problems are ordered by subject ID.
+func Lint(r *Reader) []Problem {
+	l := &linter{}
+
+	if r.Compressed() {
+		for i := range r.Header.Blocks.BlockByteOffset {
+			if _, _, err := r.blockRange(i); err != nil {
+				l.add(CheckOffsets, 0, "%v", err)
+			}
+		}
+	} else {
+		offsets := r.Header.SubjectByteOffset
+		for i, offset := range offsets {
+			if int(offset) > len(r.payload) {
+				l.add(CheckOffsets, int64(i), "offset %d is past the end of the %d byte payload", offset, len(r.payload))
+			}
+			if i > 0 && offset < offsets[i-1] {
+				l.add(CheckOffsets, int64(i), "offset %d is before the previous offset %d", offset, offsets[i-1])
+			}
+		}
+	}
+
+	listed := map[int64]listing{}
+	for i, lv := range r.Header.SubjectsByLevel {
+		for _, t := range []proto.Subject_Type{proto.Subject_RADICAL, proto.Subject_KANJI, proto.Subject_VOCABULARY} {
+			var ids []int64
+			switch t {
+			case proto.Subject_RADICAL:
+				ids = lv.Radicals
+			case proto.Subject_KANJI:
+				ids = lv.Kanji
+			case proto.Subject_VOCABULARY:
+				ids = lv.Vocabulary
+			}
+			for _, id := range ids {
+				if other, ok := listed[id]; ok {
+					l.add(CheckLevel, id, "listed in level %d as %s and in level %d as %s", other.level, other.t, i+1, t)
+					continue
+				}
+				listed[id] = listing{i + 1, t}
+			}
+		}
+	}
+
+	// Decode everything first so references can be checked in both directions.
+	var decoded []*proto.Subject
+	subjects := map[int64]*proto.Subject{}
+	unreadable := map[int64]bool{}
+	for id := int64(1); id < r.MaxID(); id++ {
+		_, start, end, err := r.span(id)
+		if err != nil {
+			// Bad offsets in uncompressed files were reported above.
+			if r.Compressed() {
+				l.add(CheckOffsets, id, "%v", err)
+			}
+			unreadable[id] = true
+			continue
+		}
+		if start == end {
+			continue
+		}
+		if r.Deleted(id) {
+			l.add(CheckDeleted, id, "deleted but still has %d bytes of data", end-start)
+			continue
+		}
+		s, err := r.Subject(id)
+		if err != nil {
+			l.add(CheckDecode, id, "%v", errors.Unwrap(err))
+			unreadable[id] = true
+			continue
+		}
+		if s.GetId() != id {
+			l.add(CheckDecode, id, "stored with ID %d", s.GetId())
+			unreadable[id] = true
+			continue
+		}
+		decoded = append(decoded, s)
+		subjects[id] = s
+	}
+	l.subjects(decoded)
+
+	for _, id := range sortedIDs(listed) {
+		lv := listed[id]
+		if r.Deleted(id) {
+			l.add(CheckDeleted, id, "deleted but listed in level %d", lv.level)
+		} else if _, ok := subjects[id]; !ok && !unreadable[id] {
+			l.add(CheckLevel, id, "listed in level %d but has no data", lv.level)
+		}
+	}
+
+	for _, id := range sortedIDs(subjects) {
+		s := subjects[id]
+		t := TypeOf(s)
+		lv, ok := listed[id]
+		switch {
+		case !ok:
+			l.add(CheckLevel, id, "not listed in subjects_by_level")
+		case lv.t == proto.Subject_KANJI && s.Kanji == nil:
+			l.add(CheckType, id, "listed as KANJI but has no Kanji message")
+		case t != proto.Subject_UNKNOWN && lv.t != t:
+			l.add(CheckType, id, "listed as %s but is a %s", lv.t, t)
+		}
+
+		// Subjects with no level at all were reported by LintSubjects.
+		if s.GetLevel() <= 0 {
+			continue
+		}
+		if level, ok := r.Level(id); !ok {
+			l.add(CheckLevel, id, "no level in level_by_subject")
+		} else if level != s.GetLevel() {
+			l.add(CheckLevel, id, "level %d but level_by_subject says %d", s.GetLevel(), level)
+		}
+		if ok && int32(lv.level) != s.GetLevel() {
+			l.add(CheckLevel, id, "level %d but listed in level %d", s.GetLevel(), lv.level)
+		}
+	}
+
+	return l.sorted()
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func problemStrings(problems []Problem) []string {
+	var ret []string
+	for _, p := range problems {
+		ret = append(ret, p.Error())
+	}
+	return ret
+}
+
+// linked returns testSubjects with amalgamations listed back.
+func linked() []*proto.Subject {
+	subjects := testSubjects()
+	subjects[0].AmalgamationSubjectIds = []int64{5}
+	subjects[1].AmalgamationSubjectIds = []int64{12}
+	subjects[2].AmalgamationSubjectIds = []int64{9}
+	return subjects
+}
+
+func TestLintSubjects(t *testing.T) {
+	if got := LintSubjects(linked()); len(got) != 0 {
+		t.Fatalf("clean subjects have problems: %v", problemStrings(got))
+	}
+
+	subjects := append(linked(),
+		&proto.Subject{Japanese: gproto.String("no ID"), Level: gproto.Int32(1), Radical: &proto.Radical{}},
+		&proto.Subject{Id: gproto.Int64(5), Level: gproto.Int32(1), Kanji: &proto.Kanji{}},
+		&proto.Subject{Id: gproto.Int64(20), Radical: &proto.Radical{}},
+		&proto.Subject{Id: gproto.Int64(21), Level: gproto.Int32(1), ComponentSubjectIds: []int64{99}},
+	)
+	subjects[0].Readings = []*proto.Reading{{Reading: gproto.String("いち")}}
+
+	want := []string{
+		`ids: subject 5 in the list ("no ID") has no ID`,
+		"subject 1: radical_readings: radical has 1 reading(s)",
+		"subject 5: ids: listed more than once",
+		"subject 20: level: has no level",
+		"subject 21: references: component 99 doesn't exist",
+		"subject 21: type: has no radical, kanji or vocabulary",
+	}
+	if got := problemStrings(LintSubjects(subjects)); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLintListedTwice(t *testing.T) {
+	w := NewWriter()
+	if err := w.AddAll(linked()); err != nil {
+		t.Fatal(err)
+	}
+	h, payload, err := w.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Radical 1 is also listed as a kanji and vocabulary in level 1.
+	h.SubjectsByLevel[0].Kanji = append(h.SubjectsByLevel[0].Kanji, 1)
+	h.SubjectsByLevel[0].Vocabulary = append(h.SubjectsByLevel[0].Vocabulary, 1)
+	var buf bytes.Buffer
+	if err := Encode(&buf, h, payload); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"subject 1: level: listed in level 1 as RADICAL and in level 1 as KANJI",
+		"subject 1: level: listed in level 1 as RADICAL and in level 1 as VOCABULARY",
+	}
+	// The message used to depend on map iteration order.
+	for i := 0; i < 20; i++ {
+		r, err := NewReader(buf.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := problemStrings(Lint(r)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %q, want %q", got, want)
+		}
+	}
+}
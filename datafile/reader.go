@@ -0,0 +1,316 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datafile reads and writes the subject data file shipped with the
+// app.
+//
+// The file is a little-endian uint32 holding the length of an encoded
+// DataFileHeader, the header itself, and then every encoded Subject one after
+// another.  subject_byte_offset[id] is where the subject with that ID starts,
+// counted from the end of the header, and it ends where the next one starts
+// (or at the end of the file).  IDs without a subject have an empty range.
+// level_by_subject is indexed by ID in the same way, and subjects_by_level[i]
+// lists the subjects of level i+1.
+package datafile
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNotFound is returned for IDs that have no subject in the file.
+	ErrNotFound = errors.New("not found")
+
+	// ErrDeleted is returned for IDs listed in deleted_subject_ids.
+	ErrDeleted = errors.New("deleted")
+)
+
+// Options controls how Open reads the file.
+type Options struct {
+	// Mmap maps the file into memory instead of reading all of it.  Where
+	// mmap isn't supported the file is read instead.
+	Mmap bool
+}
+
+// Reader decodes subjects from a data file on demand.  It is safe for
+// concurrent use.
+type Reader struct {
+	Header *proto.DataFileHeader
+
+	// data is the whole file, and payload the part after the header.
+	data    []byte
+	payload []byte
+	unmap   func() error
+
+	deleted map[int64]bool
+
+	// The most recently decompressed block of a compressed file.
+	blockMu    sync.Mutex
+	blockIndex int
+	blockData  []byte
+}
+
+// Open reads the header of a data file.  The Reader must be closed.
+func Open(path string, opts Options) (*Reader, error) {
+	var data []byte
+	var unmap func() error
+	var err error
+	if opts.Mmap {
+		data, unmap, err = mmap(path)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := NewReader(data)
+	if err != nil {
+		if unmap != nil {
+			unmap()
+		}
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	r.unmap = unmap
+	return r, nil
+}
+
+// NewReader reads the header of a data file held in memory.
+func NewReader(data []byte) (*Reader, error) {
+	if len(data) < 4 {
+		return nil, errors.New("too short for a header length")
+	}
+	length := uint64(binary.LittleEndian.Uint32(data))
+	if 4+length > uint64(len(data)) {
+		return nil, fmt.Errorf("header length %d is past the end of the file", length)
+	}
+
+	h := &proto.DataFileHeader{}
+	if err := gproto.Unmarshal(data[4:4+length], h); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+
+	if b := h.Blocks; b != nil {
+		if _, ok := proto.DataFileBlocks_Compression_name[int32(b.GetCompression())]; !ok {
+			return nil, fmt.Errorf("unknown compression %d", b.GetCompression())
+		}
+		if len(b.BlockByteOffset) != len(b.BlockLength) {
+			return nil, fmt.Errorf("%d block offsets but %d block lengths", len(b.BlockByteOffset), len(b.BlockLength))
+		}
+	}
+
+	r := &Reader{
+		Header:     h,
+		data:       data,
+		payload:    data[4+length:],
+		deleted:    map[int64]bool{},
+		blockIndex: -1,
+	}
+	for _, id := range h.DeletedSubjectIds {
+		r.deleted[int64(id)] = true
+	}
+	return r, nil
+}
+
+// Close releases the file if it was mapped.
+func (r *Reader) Close() error {
+	if r.unmap == nil {
+		return nil
+	}
+	err := r.unmap()
+	r.unmap = nil
+	r.data, r.payload = nil, nil
+	return err
+}
+
+// Deleted returns whether the ID is listed in deleted_subject_ids.
+func (r *Reader) Deleted(id int64) bool {
+	return r.deleted[id]
+}
+
+// MaxID is one more than the highest ID that can have a subject.
+func (r *Reader) MaxID() int64 {
+	return int64(len(r.Header.SubjectByteOffset))
+}
+
+// span returns where the encoded subject is: the block it's in, or -1 if
+// the file isn't compressed, and its range in that block or in the payload.
+func (r *Reader) span(id int64) (int, int, int, error) {
+	offsets := r.Header.SubjectByteOffset
+	if id <= 0 || id >= int64(len(offsets)) {
+		return -1, 0, 0, nil
+	}
+
+	block := -1
+	size := len(r.payload)
+	hasNext := id+1 < int64(len(offsets))
+	if b := r.Header.Blocks; b != nil {
+		if id >= int64(len(b.BlockBySubject)) || int(b.BlockBySubject[id]) >= len(b.BlockLength) {
+			return 0, 0, 0, errors.New("not in any block")
+		}
+		block = int(b.BlockBySubject[id])
+		size = int(b.BlockLength[block])
+		hasNext = hasNext && id+1 < int64(len(b.BlockBySubject)) && int(b.BlockBySubject[id+1]) == block
+	}
+
+	start, end := int(offsets[id]), size
+	if hasNext {
+		end = int(offsets[id+1])
+	}
+	if start > end || end > size {
+		return 0, 0, 0, fmt.Errorf("bad offsets %d to %d in %d bytes", start, end, size)
+	}
+	return block, start, end, nil
+}
+
+// Has returns whether the file has a subject with the ID that isn't deleted.
+func (r *Reader) Has(id int64) bool {
+	_, start, end, err := r.span(id)
+	return err == nil && end > start && !r.deleted[id]
+}
+
+// Encoded returns the encoded Subject with the ID, without decoding it.  In a
+// compressed file only the block holding the subject is decompressed.  The
+// returned slice must not be modified, and is only valid until Close.
+func (r *Reader) Encoded(id int64) ([]byte, error) {
+	if r.deleted[id] {
+		return nil, fmt.Errorf("subject %d: %w", id, ErrDeleted)
+	}
+	block, start, end, err := r.span(id)
+	if err != nil {
+		return nil, fmt.Errorf("subject %d: %w", id, err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("subject %d: %w", id, ErrNotFound)
+	}
+	if block < 0 {
+		return r.payload[start:end], nil
+	}
+	data, err := r.block(block)
+	if err != nil {
+		return nil, fmt.Errorf("subject %d: %w", id, err)
+	}
+	return data[start:end], nil
+}
+
+// Subject decodes the subject with the ID.  Its id field is set even if the
+// file doesn't store it.
+func (r *Reader) Subject(id int64) (*proto.Subject, error) {
+	b, err := r.Encoded(id)
+	if err != nil {
+		return nil, err
+	}
+	s := &proto.Subject{}
+	if err := gproto.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("subject %d: %w", id, err)
+	}
+	if s.Id == nil {
+		s.Id = gproto.Int64(id)
+	}
+	return s, nil
+}
+
+// IDs returns the ID of every subject that isn't deleted, in order.
+func (r *Reader) IDs() []int64 {
+	var ret []int64
+	for id := int64(1); id < r.MaxID(); id++ {
+		if r.Has(id) {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// Level returns the level of a subject, or false if it has none or is
+// deleted.
+func (r *Reader) Level(id int64) (int32, bool) {
+	levels := r.Header.LevelBySubject
+	if id <= 0 || id >= int64(len(levels)) || r.deleted[id] || levels[id] == 0 {
+		return 0, false
+	}
+	return levels[id], true
+}
+
+// MaxLevel is the highest level in subjects_by_level.
+func (r *Reader) MaxLevel() int {
+	return len(r.Header.SubjectsByLevel)
+}
+
+func (r *Reader) live(ids []int64) []int64 {
+	var ret []int64
+	for _, id := range ids {
+		if !r.deleted[id] {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// ByLevel returns the subjects of a level that aren't deleted: radicals, then
+// kanji, then vocabulary.
+func (r *Reader) ByLevel(level int) []int64 {
+	var ret []int64
+	for _, t := range []proto.Subject_Type{proto.Subject_RADICAL, proto.Subject_KANJI, proto.Subject_VOCABULARY} {
+		ret = append(ret, r.ByLevelAndType(level, t)...)
+	}
+	return ret
+}
+
+// ByLevelAndType returns the subjects of one type in a level that aren't
+// deleted.
+func (r *Reader) ByLevelAndType(level int, t proto.Subject_Type) []int64 {
+	if level < 1 || level > r.MaxLevel() {
+		return nil
+	}
+	l := r.Header.SubjectsByLevel[level-1]
+	switch t {
+	case proto.Subject_RADICAL:
+		return r.live(l.Radicals)
+	case proto.Subject_KANJI:
+		return r.live(l.Kanji)
+	case proto.Subject_VOCABULARY:
+		return r.live(l.Vocabulary)
+	}
+	return nil
+}
+
+// ByType returns the subjects of one type in every level that aren't deleted,
+// ordered by level.
+func (r *Reader) ByType(t proto.Subject_Type) []int64 {
+	var ret []int64
+	for level := 1; level <= r.MaxLevel(); level++ {
+		ret = append(ret, r.ByLevelAndType(level, t)...)
+	}
+	return ret
+}
+
+// Subjects decodes every subject that isn't deleted, in ID order.
+func (r *Reader) Subjects() ([]*proto.Subject, error) {
+	var ret []*proto.Subject
+	for _, id := range r.IDs() {
+		s, err := r.Subject(id)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
@@ -0,0 +1,384 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search builds a search index of the subjects in a data file, and
+// saves it in a sidecar file next to it so lookups don't have to decode every
+// subject.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	"github.com/davidsansome/tsurukame/utils"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// Field is the part of a subject a term came from.  The values are the same
+// as SearchTerm.Field's.
+type Field uint8
+
+const (
+	FieldMeaning Field = iota
+	FieldReading
+	FieldRomaji
+	FieldJapanese
+	FieldSlug
+	FieldMnemonic
+)
+
+func (f Field) String() string {
+	switch f {
+	case FieldMeaning:
+		return "meaning"
+	case FieldReading:
+		return "reading"
+	case FieldRomaji:
+		return "romaji"
+	case FieldJapanese:
+		return "japanese"
+	case FieldSlug:
+		return "slug"
+	case FieldMnemonic:
+		return "mnemonic"
+	}
+	return fmt.Sprintf("Field(%d)", int(f))
+}
+
+// How much a term counts for, by where it came from.  Blacklisted meanings
+// are wrong answers, so they aren't indexed at all.
+var meaningWeights = map[proto.Meaning_Type]float32{
+	proto.Meaning_UNKNOWN:             0.8,
+	proto.Meaning_PRIMARY:             1.0,
+	proto.Meaning_SECONDARY:           0.8,
+	proto.Meaning_AUXILIARY_WHITELIST: 0.5,
+}
+
+const (
+	weightJapanese       = 1.0
+	weightPrimaryReading = 0.9
+	weightReading        = 0.7
+	weightSlug           = 0.6
+	weightMnemonic       = 0.2
+)
+
+// ErrStale is returned by Load when the index was built from a different
+// data file.
+var ErrStale = errors.New("search index is out of date")
+
+// Posting is one occurrence of a term.
+type Posting struct {
+	SubjectID int64
+	Field     Field
+	Weight    float32
+}
+
+// SubjectInfo is enough about a subject to show it in search results.
+type SubjectInfo struct {
+	Type     proto.Subject_Type
+	Japanese string
+	Level    int32
+}
+
+// Index maps normalized terms to the subjects they appear in.
+type Index struct {
+	// DataChecksum is the datafile.Checksum of the file the index was built
+	// from, or empty if it was built from a list of subjects.
+	DataChecksum string
+
+	// Terms is sorted, and Postings[i] are the postings for Terms[i].  They
+	// shouldn't be changed after Build or FromProto, which also index the
+	// terms by length.
+	Terms    []string
+	Postings [][]Posting
+
+	Subjects map[int64]SubjectInfo
+
+	// byLength[n] are the positions in Terms of the terms with n characters,
+	// so fuzzy matching only has to look at terms of about the right length.
+	byLength [][]int
+}
+
+func (idx *Index) indexLengths() {
+	idx.byLength = nil
+	for i, term := range idx.Terms {
+		n := utf8.RuneCountInString(term)
+		for len(idx.byLength) <= n {
+			idx.byLength = append(idx.byLength, nil)
+		}
+		idx.byLength[n] = append(idx.byLength[n], i)
+	}
+}
+
+// Normalize lowercases s, converts katakana to hiragana and collapses runs of
+// whitespace, so queries and indexed text compare equal.
+func Normalize(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(utils.ToHiragana(s))), " ")
+}
+
+// Words in free text shorter than this many characters aren't indexed.
+const minWordSize = 3
+
+var (
+	markupRe  = regexp.MustCompile(`<[^>]*>`)
+	stopWords = map[string]bool{}
+)
+
+func init() {
+	for _, w := range strings.Fields(`
+		and are but can for from had has have her his its not that the their
+		there they this was what when which who will with you your`) {
+		stopWords[w] = true
+	}
+}
+
+// words splits free text, like a mnemonic, into the words worth indexing.
+func words(text string) []string {
+	text = markupRe.ReplaceAllString(text, " ")
+	ret := []string{}
+	for _, w := range strings.FieldsFunc(Normalize(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if len([]rune(w)) >= minWordSize && !stopWords[w] {
+			ret = append(ret, w)
+		}
+	}
+	return ret
+}
+
+// kana strips anything that isn't kana from a reading, like the "!" on
+// unusual readings.
+func kana(reading string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Hiragana, r) || r == 'ー' {
+			return r
+		}
+		return -1
+	}, utils.ToHiragana(reading))
+}
+
+type builder struct {
+	// Only the best posting for each term and subject is kept.
+	best map[string]map[int64]Posting
+}
+
+func (b *builder) add(term string, id int64, f Field, weight float32) {
+	if term == "" {
+		return
+	}
+	bySubject, ok := b.best[term]
+	if !ok {
+		bySubject = map[int64]Posting{}
+		b.best[term] = bySubject
+	}
+	if p, ok := bySubject[id]; ok && p.Weight >= weight {
+		return
+	}
+	bySubject[id] = Posting{id, f, weight}
+}
+
+func (b *builder) addText(text string, id int64, f Field, weight float32) {
+	// Index the whole phrase and each word in it, so "big dog" can be found by
+	// "big dog", "big" or "dog".  Single words are worth a bit less.
+	phrase := Normalize(text)
+	b.add(phrase, id, f, weight)
+	if parts := strings.Fields(phrase); len(parts) > 1 {
+		for _, w := range parts {
+			if !stopWords[w] {
+				b.add(w, id, f, weight*0.8)
+			}
+		}
+	}
+}
+
+func (b *builder) addSubject(s *proto.Subject) {
+	id := s.GetId()
+	for _, m := range s.Meanings {
+		if w, ok := meaningWeights[m.GetType()]; ok {
+			b.addText(m.GetMeaning(), id, FieldMeaning, w)
+		}
+	}
+	for _, r := range s.Readings {
+		w := float32(weightReading)
+		if r.GetIsPrimary() {
+			w = weightPrimaryReading
+		}
+		k := kana(r.GetReading())
+		b.add(k, id, FieldReading, w)
+		b.add(Romaji(k), id, FieldRomaji, w)
+	}
+	b.add(Normalize(s.GetJapanese()), id, FieldJapanese, weightJapanese)
+	b.addText(strings.ReplaceAll(s.GetSlug(), "-", " "), id, FieldSlug, weightSlug)
+
+	var text []string
+	if r := s.Radical; r != nil {
+		text = append(text, r.GetMnemonic(), r.GetDeprecatedMnemonic())
+	}
+	if k := s.Kanji; k != nil {
+		text = append(text, k.GetMeaningMnemonic(), k.GetMeaningHint(), k.GetReadingMnemonic(), k.GetReadingHint())
+	}
+	if v := s.Vocabulary; v != nil {
+		text = append(text, v.GetMeaningExplanation(), v.GetReadingExplanation())
+	}
+	for _, t := range text {
+		for _, w := range words(t) {
+			b.add(w, id, FieldMnemonic, weightMnemonic)
+		}
+	}
+}
+
+// Build indexes a list of subjects.
+func Build(subjects []*proto.Subject) *Index {
+	b := &builder{best: map[string]map[int64]Posting{}}
+	idx := &Index{Subjects: map[int64]SubjectInfo{}}
+	for _, s := range subjects {
+		b.addSubject(s)
+		idx.Subjects[s.GetId()] = SubjectInfo{datafile.TypeOf(s), s.GetJapanese(), s.GetLevel()}
+	}
+
+	for term := range b.best {
+		idx.Terms = append(idx.Terms, term)
+	}
+	sort.Strings(idx.Terms)
+	idx.Postings = make([][]Posting, len(idx.Terms))
+	for i, term := range idx.Terms {
+		var p []Posting
+		for _, posting := range b.best[term] {
+			p = append(p, posting)
+		}
+		sort.Slice(p, func(i, j int) bool { return p[i].SubjectID < p[j].SubjectID })
+		idx.Postings[i] = p
+	}
+	idx.indexLengths()
+	return idx
+}
+
+// BuildFromFile indexes every subject in a data file.
+func BuildFromFile(data []byte) (*Index, error) {
+	r, err := datafile.NewReader(data)
+	if err != nil {
+		return nil, err
+	}
+	subjects, err := r.Subjects()
+	if err != nil {
+		return nil, err
+	}
+	idx := Build(subjects)
+	idx.DataChecksum = datafile.Checksum(data)
+	return idx, nil
+}
+
+// Proto returns the index as a SearchIndex message.
+func (idx *Index) Proto() *proto.SearchIndex {
+	ret := &proto.SearchIndex{}
+	if idx.DataChecksum != "" {
+		ret.DataSha256 = gproto.String(idx.DataChecksum)
+	}
+	for i, term := range idx.Terms {
+		t := &proto.SearchTerm{Term: gproto.String(term)}
+		for _, p := range idx.Postings[i] {
+			t.SubjectId = append(t.SubjectId, p.SubjectID)
+			t.Field = append(t.Field, proto.SearchTerm_Field(p.Field))
+			t.Weight = append(t.Weight, p.Weight)
+		}
+		ret.Terms = append(ret.Terms, t)
+	}
+
+	ids := make([]int64, 0, len(idx.Subjects))
+	for id := range idx.Subjects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		info := idx.Subjects[id]
+		ret.Subjects = append(ret.Subjects, &proto.SearchSubject{
+			Id:       gproto.Int64(id),
+			Type:     info.Type.Enum(),
+			Japanese: gproto.String(info.Japanese),
+			Level:    gproto.Int32(info.Level),
+		})
+	}
+	return ret
+}
+
+// FromProto reads an index from a SearchIndex message.
+func FromProto(m *proto.SearchIndex) (*Index, error) {
+	idx := &Index{
+		DataChecksum: m.GetDataSha256(),
+		Terms:        make([]string, len(m.Terms)),
+		Postings:     make([][]Posting, len(m.Terms)),
+		Subjects:     map[int64]SubjectInfo{},
+	}
+	for i, t := range m.Terms {
+		if i > 0 && t.GetTerm() <= idx.Terms[i-1] {
+			return nil, fmt.Errorf("term %q is out of order", t.GetTerm())
+		}
+		if len(t.Field) != len(t.SubjectId) || len(t.Weight) != len(t.SubjectId) {
+			return nil, fmt.Errorf("term %q has %d subject IDs, %d fields and %d weights",
+				t.GetTerm(), len(t.SubjectId), len(t.Field), len(t.Weight))
+		}
+		idx.Terms[i] = t.GetTerm()
+		p := make([]Posting, len(t.SubjectId))
+		for j, id := range t.SubjectId {
+			p[j] = Posting{id, Field(t.Field[j]), t.Weight[j]}
+		}
+		idx.Postings[i] = p
+	}
+	for _, s := range m.Subjects {
+		idx.Subjects[s.GetId()] = SubjectInfo{s.GetType(), s.GetJapanese(), s.GetLevel()}
+	}
+	idx.indexLengths()
+	return idx, nil
+}
+
+// Save writes the index to a file as a SearchIndex message.
+func (idx *Index) Save(path string) error {
+	b, err := gproto.MarshalOptions{Deterministic: true}.Marshal(idx.Proto())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// Load reads an index written by Save.  If data is not nil it's the data file
+// the index is for, and ErrStale is returned if the index was built from
+// something else.
+func Load(path string, data []byte) (*Index, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &proto.SearchIndex{}
+	if err := gproto.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	idx, err := FromProto(m)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if data != nil {
+		if sum := datafile.Checksum(data); sum != idx.DataChecksum {
+			return nil, fmt.Errorf("%s: %w", path, ErrStale)
+		}
+	}
+	return idx, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func testSubjects() []*proto.Subject {
+	return []*proto.Subject{
+		{
+			Id: gproto.Int64(440), Level: gproto.Int32(1), Japanese: gproto.String("一"),
+			Meanings: []*proto.Meaning{{Meaning: gproto.String("One"), Type: proto.Meaning_PRIMARY.Enum()}},
+			Readings: []*proto.Reading{{Reading: gproto.String("いち"), IsPrimary: gproto.Bool(true)}},
+			Kanji:    &proto.Kanji{MeaningMnemonic: gproto.String("Lying on the <radical>ground</radical> is one thing.")},
+		},
+		{
+			Id: gproto.Int64(2467), Level: gproto.Int32(1), Japanese: gproto.String("一つ"),
+			Meanings: []*proto.Meaning{
+				{Meaning: gproto.String("One Thing"), Type: proto.Meaning_PRIMARY.Enum()},
+				{Meaning: gproto.String("None"), Type: proto.Meaning_BLACKLIST.Enum()},
+			},
+			Readings:   []*proto.Reading{{Reading: gproto.String("ひとつ"), IsPrimary: gproto.Bool(true)}},
+			Vocabulary: &proto.Vocabulary{},
+		},
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	idx := Build(testSubjects())
+	idx.DataChecksum = "abc"
+	path := filepath.Join(t.TempDir(), "data.bin.idx")
+	if err := idx.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Load(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, idx) {
+		t.Errorf("got %+v, want %+v", got, idx)
+	}
+
+	// The sidecar is a SearchIndex message, so anything can read it.
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &proto.SearchIndex{}
+	if err := gproto.Unmarshal(b, m); err != nil {
+		t.Fatal(err)
+	}
+	if !gproto.Equal(m, idx.Proto()) {
+		t.Errorf("saved %v, want %v", m, idx.Proto())
+	}
+
+	if _, err := Load(path, []byte("another file")); !errors.Is(err, ErrStale) {
+		t.Errorf("Load with another file = %v, want ErrStale", err)
+	}
+}
+
+func TestFromProtoErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		m    *proto.SearchIndex
+	}{
+		{"out of order", &proto.SearchIndex{Terms: []*proto.SearchTerm{
+			{Term: gproto.String("b")}, {Term: gproto.String("a")},
+		}}},
+		{"mismatched postings", &proto.SearchIndex{Terms: []*proto.SearchTerm{
+			{Term: gproto.String("a"), SubjectId: []int64{1, 2}, Field: []proto.SearchTerm_Field{proto.SearchTerm_MEANING}, Weight: []float32{1, 1}},
+		}}},
+	} {
+		if _, err := FromProto(tc.m); err == nil {
+			t.Errorf("%s: no error", tc.name)
+		}
+	}
+}
+
+func TestSearchOne(t *testing.T) {
+	// "one" is a meaning, so it isn't a stop word.
+	idx := Build(testSubjects())
+	var got []int64
+	for _, r := range idx.Search("one", Options{MaxDistance: -1}) {
+		got = append(got, r.SubjectID)
+	}
+	if want := []int64{440, 2467}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
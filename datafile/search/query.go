@@ -0,0 +1,202 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+// Match is how well a term matched the query.  Better matches have higher
+// values.
+type Match uint8
+
+const (
+	MatchFuzzy Match = iota + 1
+	MatchPrefix
+	MatchExact
+)
+
+func (m Match) String() string {
+	switch m {
+	case MatchFuzzy:
+		return "fuzzy"
+	case MatchPrefix:
+		return "prefix"
+	case MatchExact:
+		return "exact"
+	}
+	return "none"
+}
+
+// Result is one subject found by Search, with the best term it matched on.
+type Result struct {
+	SubjectID int64
+	Match     Match
+	Field     Field
+	Term      string
+	Score     float32
+}
+
+// Options control a search.
+type Options struct {
+	// Limit is the maximum number of results.  0 means no limit.
+	Limit int
+
+	// MaxDistance is the largest edit distance allowed for a fuzzy match.  If
+	// it's 0 the distance depends on the length of the query: none for up to 3
+	// characters, 1 for up to 6 and 2 after that.  Use -1 to turn off fuzzy
+	// matching.
+	MaxDistance int
+}
+
+func maxDistance(query []rune, opts Options) int {
+	switch {
+	case opts.MaxDistance != 0:
+		return opts.MaxDistance
+	case len(query) <= 3:
+		return 0
+	case len(query) <= 6:
+		return 1
+	}
+	return 2
+}
+
+// Search finds subjects matching the query.  Results are ordered by match
+// type, so every exact match comes before every prefix match and every prefix
+// match before every fuzzy one, then by score, then by level.  Each subject
+// appears once, under the best term it matched.
+func (idx *Index) Search(query string, opts Options) []Result {
+	q := Normalize(query)
+	if q == "" {
+		return nil
+	}
+	best := map[int64]Result{}
+	collect := func(i int, m Match, score float32) {
+		for _, p := range idx.Postings[i] {
+			r := Result{p.SubjectID, m, p.Field, idx.Terms[i], score * p.Weight}
+			if old, ok := best[p.SubjectID]; ok && !better(r, old) {
+				continue
+			}
+			best[p.SubjectID] = r
+		}
+	}
+
+	// Exact and prefix matches are a contiguous range of the sorted terms.
+	start := sort.SearchStrings(idx.Terms, q)
+	for i := start; i < len(idx.Terms) && strings.HasPrefix(idx.Terms[i], q); i++ {
+		if idx.Terms[i] == q {
+			collect(i, MatchExact, 1)
+		} else {
+			// Prefer terms that are closer to the length of the query.
+			collect(i, MatchPrefix, float32(len(q))/float32(len(idx.Terms[i])))
+		}
+	}
+
+	qr := []rune(q)
+	if d := maxDistance(qr, opts); d > 0 {
+		// A term more than d characters longer or shorter than the query is
+		// more than d edits away.
+		for n := len(qr) - d; n <= len(qr)+d; n++ {
+			if n < 0 || n >= len(idx.byLength) {
+				continue
+			}
+			for _, i := range idx.byLength[n] {
+				term := idx.Terms[i]
+				if strings.HasPrefix(term, q) {
+					continue
+				}
+				if dist := distance(qr, []rune(term), d); dist <= d {
+					collect(i, MatchFuzzy, 1/float32(dist+1))
+				}
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(best))
+	for _, r := range best {
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Match != b.Match || a.Score != b.Score {
+			return better(a, b)
+		}
+		la, lb := idx.Subjects[a.SubjectID].Level, idx.Subjects[b.SubjectID].Level
+		if la != lb {
+			return la < lb
+		}
+		return a.SubjectID < b.SubjectID
+	})
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results
+}
+
+func better(a, b Result) bool {
+	if a.Match != b.Match {
+		return a.Match > b.Match
+	}
+	return a.Score > b.Score
+}
+
+// distance is the edit distance between a and b, counting a swap of two
+// neighbouring characters as one edit, or max+1 if it's more than max.
+func distance(a, b []rune, max int) int {
+	if d := len(a) - len(b); d > max || -d > max {
+		return max + 1
+	}
+	// Three rows of the table: the one being filled in and the two above it.
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if v := prev[j] + 1; v < cur[j] {
+				cur[j] = v
+			}
+			if v := cur[j-1] + 1; v < cur[j] {
+				cur[j] = v
+			}
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if v := prev2[j-2] + 1; v < cur[j] {
+					cur[j] = v
+				}
+			}
+			if cur[j] < rowMin {
+				rowMin = cur[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	if prev[len(b)] > max {
+		return max + 1
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,208 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func meaningSubject(id int64, level int32, meaning string, t proto.Meaning_Type) *proto.Subject {
+	return &proto.Subject{
+		Id: gproto.Int64(id), Level: gproto.Int32(level), Japanese: gproto.String("犬"),
+		Meanings: []*proto.Meaning{{Meaning: gproto.String(meaning), Type: t.Enum()}},
+		Kanji:    &proto.Kanji{},
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	idx := Build([]*proto.Subject{
+		meaningSubject(1, 3, "Dog", proto.Meaning_PRIMARY),
+		meaningSubject(2, 1, "Doggy", proto.Meaning_PRIMARY),
+		meaningSubject(3, 2, "Dot", proto.Meaning_PRIMARY),
+		meaningSubject(4, 1, "Dog", proto.Meaning_SECONDARY),
+		meaningSubject(5, 2, "Dog", proto.Meaning_AUXILIARY_WHITELIST),
+		meaningSubject(6, 1, "Dog", proto.Meaning_BLACKLIST),
+		meaningSubject(7, 1, "Dog", proto.Meaning_PRIMARY),
+		meaningSubject(8, 1, "Hot Dog", proto.Meaning_PRIMARY),
+	})
+
+	type result struct {
+		id    int64
+		match Match
+		score float32
+	}
+	for _, tc := range []struct {
+		name  string
+		query string
+		opts  Options
+		want  []result
+	}{
+		{
+			// Exact matches come first however low their weight, then
+			// prefix matches, then fuzzy ones.  Matches of the same type are
+			// ordered by score, then by level, then by ID.
+			name:  "exact, prefix, fuzzy",
+			query: "dog",
+			opts:  Options{MaxDistance: 1},
+			want: []result{
+				{7, MatchExact, 1},
+				{1, MatchExact, 1},
+				{4, MatchExact, 0.8},
+				{8, MatchExact, 0.8},
+				{5, MatchExact, 0.5},
+				{2, MatchPrefix, 0.6},
+				{3, MatchFuzzy, 0.5},
+			},
+		},
+		{
+			name:  "no fuzzy matching for short queries",
+			query: "Dog",
+			want: []result{
+				{7, MatchExact, 1},
+				{1, MatchExact, 1},
+				{4, MatchExact, 0.8},
+				{8, MatchExact, 0.8},
+				{5, MatchExact, 0.5},
+				{2, MatchPrefix, 0.6},
+			},
+		},
+		{
+			name:  "fuzzy matching turned off",
+			query: "dot",
+			opts:  Options{MaxDistance: -1},
+			want:  []result{{3, MatchExact, 1}},
+		},
+		{
+			name:  "limit",
+			query: "dog",
+			opts:  Options{Limit: 2},
+			want:  []result{{7, MatchExact, 1}, {1, MatchExact, 1}},
+		},
+		{
+			name:  "phrase",
+			query: "hot  DOG",
+			want:  []result{{8, MatchExact, 1}},
+		},
+		{
+			name:  "nothing",
+			query: "cat",
+		},
+		{
+			name:  "empty query",
+			query: " ",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []result
+			for _, r := range idx.Search(tc.query, tc.opts) {
+				got = append(got, result{r.SubjectID, r.Match, r.Score})
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSearchFields(t *testing.T) {
+	idx := Build([]*proto.Subject{{
+		Id: gproto.Int64(1), Level: gproto.Int32(1), Japanese: gproto.String("犬"), Slug: gproto.String("dog-house"),
+		Meanings: []*proto.Meaning{{Meaning: gproto.String("Dog"), Type: proto.Meaning_PRIMARY.Enum()}},
+		Readings: []*proto.Reading{
+			{Reading: gproto.String("いぬ"), IsPrimary: gproto.Bool(true)},
+			{Reading: gproto.String("けん")},
+		},
+		Kanji: &proto.Kanji{MeaningMnemonic: gproto.String("A <kanji>dog</kanji> barks at the mailman.")},
+	}})
+
+	for _, tc := range []struct {
+		query string
+		field Field
+		score float32
+	}{
+		{"dog", FieldMeaning, 1},
+		{"犬", FieldJapanese, 1},
+		{"イヌ", FieldReading, 0.9},
+		{"inu", FieldRomaji, 0.9},
+		{"けん", FieldReading, 0.7},
+		{"dog house", FieldSlug, 0.6},
+		{"mailman", FieldMnemonic, 0.2},
+	} {
+		results := idx.Search(tc.query, Options{MaxDistance: -1})
+		if len(results) != 1 || results[0].Field != tc.field || results[0].Score != tc.score {
+			t.Errorf("Search(%q) = %+v, want %v with score %v", tc.query, results, tc.field, tc.score)
+		}
+	}
+	for _, query := range []string{"the", "at"} {
+		if results := idx.Search(query, Options{MaxDistance: -1}); len(results) != 0 {
+			t.Errorf("Search(%q) = %+v, want nothing", query, results)
+		}
+	}
+}
+
+func TestSearchFuzzyLengths(t *testing.T) {
+	var subjects []*proto.Subject
+	for i, m := range []string{"Elepha", "Elefant", "Elephant", "Eleephaant", "Eleph", "Eleephaantt", "Elephaant"} {
+		subjects = append(subjects, meaningSubject(int64(i+1), 1, m, proto.Meaning_PRIMARY))
+	}
+	for _, idx := range []*Index{Build(subjects), mustFromProto(t, Build(subjects).Proto())} {
+		var got []int64
+		for _, r := range idx.Search("elephant", Options{MaxDistance: 2}) {
+			got = append(got, r.SubjectID)
+		}
+		// Elephaant is 1 edit away, and Elepha, Elefant and Eleephaant are 2
+		// away, at both ends of the lengths that are looked at.  Eleph and
+		// Eleephaantt are 3 away.
+		if want := []int64{3, 7, 1, 2, 4}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func mustFromProto(t *testing.T, m *proto.SearchIndex) *Index {
+	t.Helper()
+	idx, err := FromProto(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+func TestDistance(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"dog", "dog", 2, 0},
+		{"dog", "dot", 2, 1},
+		{"dog", "god", 2, 2},
+		{"dog", "dgo", 2, 1},
+		{"dog", "do", 2, 1},
+		{"dog", "doggy", 2, 2},
+		{"dog", "doggie", 2, 3},
+		{"dog", "cat", 2, 3},
+		{"いぬ", "いぬい", 1, 1},
+		{"", "ab", 2, 2},
+	} {
+		if got := distance([]rune(tc.a), []rune(tc.b), tc.max); got != tc.want {
+			t.Errorf("distance(%q, %q, %d) = %d, want %d", tc.a, tc.b, tc.max, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/davidsansome/tsurukame/proto"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// ReadSubjects reads a stream of length-delimited Subject messages.
+func ReadSubjects(r io.Reader) ([]*proto.Subject, error) {
+	br := bufio.NewReader(r)
+	var ret []*proto.Subject
+	for {
+		s := &proto.Subject{}
+		if err := protodelim.UnmarshalFrom(br, s); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ret, nil
+			}
+			return nil, err
+		}
+		ret = append(ret, s)
+	}
+}
+
+// WriteSubjects writes subjects as a stream of length-delimited messages.
+func WriteSubjects(w io.Writer, subjects []*proto.Subject) error {
+	bw := bufio.NewWriter(w)
+	for _, s := range subjects {
+		if _, err := protodelim.MarshalTo(bw, s); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// Verify checks that the Reader gives back exactly the subjects and deleted
+// IDs a file was written from: every subject decodes to the same message, is
+// listed under its level and type, and nothing else is.  It returns every
+// difference it finds.
+func Verify(r *Reader, subjects []*proto.Subject, deleted []int64) error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	want := map[int64]*proto.Subject{}
+	var wantIDs []int64
+	for _, s := range subjects {
+		want[s.GetId()] = s
+		wantIDs = append(wantIDs, s.GetId())
+	}
+	sort.Slice(wantIDs, func(i, j int) bool { return wantIDs[i] < wantIDs[j] })
+
+	gotIDs := r.IDs()
+	if fmt.Sprint(gotIDs) != fmt.Sprint(wantIDs) {
+		fail("read %d subject IDs, want %d", len(gotIDs), len(wantIDs))
+	}
+
+	for _, id := range wantIDs {
+		s := want[id]
+		got, err := r.Subject(id)
+		if err != nil {
+			fail("subject %d: %w", id, err)
+			continue
+		}
+		if !gproto.Equal(got, s) {
+			fail("subject %d: decoded subject differs", id)
+		}
+		if level, ok := r.Level(id); !ok || level != s.GetLevel() {
+			fail("subject %d: level %d, want %d", id, level, s.GetLevel())
+		}
+		found := false
+		for _, other := range r.ByLevelAndType(int(s.GetLevel()), TypeOf(s)) {
+			if other == id {
+				found = true
+			}
+		}
+		if !found {
+			fail("subject %d: not listed in level %d as %s", id, s.GetLevel(), TypeOf(s))
+		}
+	}
+
+	listed := 0
+	for level := 1; level <= r.MaxLevel(); level++ {
+		listed += len(r.ByLevel(level))
+	}
+	if listed != len(wantIDs) {
+		fail("%d subjects listed by level, want %d", listed, len(wantIDs))
+	}
+
+	for _, id := range deleted {
+		if !r.Deleted(id) {
+			fail("subject %d: not deleted", id)
+		}
+		if _, err := r.Subject(id); !errors.Is(err, ErrDeleted) {
+			fail("subject %d: got %v, want %v", id, err, ErrDeleted)
+		}
+	}
+	if len(r.Header.DeletedSubjectIds) != len(deleted) {
+		fail("%d deleted subject IDs, want %d", len(r.Header.DeletedSubjectIds), len(deleted))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,185 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// TypeOf returns the type of a subject from which of radical, kanji and
+// vocabulary is set.
+func TypeOf(s *proto.Subject) proto.Subject_Type {
+	switch {
+	case s.Radical != nil:
+		return proto.Subject_RADICAL
+	case s.Kanji != nil:
+		return proto.Subject_KANJI
+	case s.Vocabulary != nil:
+		return proto.Subject_VOCABULARY
+	}
+	return proto.Subject_UNKNOWN
+}
+
+// Writer builds a data file from subjects.
+type Writer struct {
+	// BlockSize, if set, stores the subjects in blocks compressed with
+	// DEFLATE, each holding at least BlockSize bytes of encoded subjects.
+	BlockSize int
+
+	subjects map[int64]*proto.Subject
+	deleted  map[int64]bool
+}
+
+func NewWriter() *Writer {
+	return &Writer{
+		subjects: map[int64]*proto.Subject{},
+		deleted:  map[int64]bool{},
+	}
+}
+
+// Add adds a subject.  It must have an ID, a level and a type, and its ID
+// can't have been added already.
+func (w *Writer) Add(s *proto.Subject) error {
+	id := s.GetId()
+	switch {
+	case id <= 0:
+		return fmt.Errorf("subject %q has no ID", s.GetJapanese())
+	case s.GetLevel() <= 0:
+		return fmt.Errorf("subject %d has no level", id)
+	case TypeOf(s) == proto.Subject_UNKNOWN:
+		return fmt.Errorf("subject %d has no radical, kanji or vocabulary", id)
+	}
+	if _, ok := w.subjects[id]; ok {
+		return fmt.Errorf("subject %d is added twice", id)
+	}
+	w.subjects[id] = s
+	return nil
+}
+
+// AddAll adds every subject in order, stopping at the first error.
+func (w *Writer) AddAll(subjects []*proto.Subject) error {
+	for _, s := range subjects {
+		if err := w.Add(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete records that a subject ID no longer has any data.
+func (w *Writer) Delete(id int64) {
+	w.deleted[id] = true
+}
+
+// Build returns the header and the encoded payload of every subject, which is
+// compressed if BlockSize is set.
+func (w *Writer) Build() (*proto.DataFileHeader, []byte, error) {
+	var ids []int64
+	var maxID int64
+	for id := range w.subjects {
+		if w.deleted[id] {
+			return nil, nil, fmt.Errorf("subject %d is both added and deleted", id)
+		}
+		ids = append(ids, id)
+		if id > maxID {
+			maxID = id
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := &proto.DataFileHeader{
+		SubjectByteOffset: make([]uint32, maxID+1),
+		LevelBySubject:    make([]int32, maxID+1),
+	}
+	var payload []byte
+	opts := gproto.MarshalOptions{Deterministic: true}
+	next := 0
+	for _, id := range ids {
+		// IDs without a subject get an empty range at the current offset.
+		for ; int64(next) <= id; next++ {
+			h.SubjectByteOffset[next] = uint32(len(payload))
+		}
+
+		s := w.subjects[id]
+		var err error
+		if payload, err = opts.MarshalAppend(payload, s); err != nil {
+			return nil, nil, fmt.Errorf("subject %d: %w", id, err)
+		}
+		if uint64(len(payload)) > 1<<32-1 {
+			return nil, nil, fmt.Errorf("subject %d: payload is over 4GB", id)
+		}
+
+		level := s.GetLevel()
+		h.LevelBySubject[id] = level
+		for int(level) > len(h.SubjectsByLevel) {
+			h.SubjectsByLevel = append(h.SubjectsByLevel, &proto.SubjectsByLevel{})
+		}
+		l := h.SubjectsByLevel[level-1]
+		switch TypeOf(s) {
+		case proto.Subject_RADICAL:
+			l.Radicals = append(l.Radicals, id)
+		case proto.Subject_KANJI:
+			l.Kanji = append(l.Kanji, id)
+		case proto.Subject_VOCABULARY:
+			l.Vocabulary = append(l.Vocabulary, id)
+		}
+	}
+
+	for id := range w.deleted {
+		h.DeletedSubjectIds = append(h.DeletedSubjectIds, int32(id))
+	}
+	sort.Slice(h.DeletedSubjectIds, func(i, j int) bool {
+		return h.DeletedSubjectIds[i] < h.DeletedSubjectIds[j]
+	})
+
+	if w.BlockSize > 0 {
+		var err error
+		if payload, err = compressBlocks(h, payload, w.BlockSize); err != nil {
+			return nil, nil, err
+		}
+	}
+	return h, payload, nil
+}
+
+// Encode writes a header and payload in the data file layout.
+func Encode(out io.Writer, h *proto.DataFileHeader, payload []byte) error {
+	header, err := gproto.MarshalOptions{Deterministic: true}.Marshal(h)
+	if err != nil {
+		return err
+	}
+	if _, err := out.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(header)))); err != nil {
+		return err
+	}
+	if _, err := out.Write(header); err != nil {
+		return err
+	}
+	_, err = out.Write(payload)
+	return err
+}
+
+// Write builds the data file and writes it.
+func (w *Writer) Write(out io.Writer) error {
+	h, payload, err := w.Build()
+	if err != nil {
+		return err
+	}
+	return Encode(out, h, payload)
+}
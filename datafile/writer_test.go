@@ -0,0 +1,207 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datafile
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func testSubjects() []*proto.Subject {
+	meaning := func(m string) []*proto.Meaning {
+		return []*proto.Meaning{{Meaning: gproto.String(m), Type: proto.Meaning_PRIMARY.Enum()}}
+	}
+	return []*proto.Subject{
+		{
+			Id: gproto.Int64(1), Level: gproto.Int32(1), Japanese: gproto.String("一"),
+			Meanings: meaning("Ground"), Radical: &proto.Radical{},
+		},
+		{
+			Id: gproto.Int64(2), Level: gproto.Int32(1), Japanese: gproto.String("口"),
+			Meanings: meaning("Mouth"), Radical: &proto.Radical{},
+		},
+		{
+			Id: gproto.Int64(5), Level: gproto.Int32(1), Japanese: gproto.String("一"),
+			Meanings: meaning("One"), Kanji: &proto.Kanji{},
+			ComponentSubjectIds: []int64{1},
+		},
+		{
+			Id: gproto.Int64(9), Level: gproto.Int32(2), Japanese: gproto.String("一つ"),
+			Meanings: meaning("One Thing"), Vocabulary: &proto.Vocabulary{},
+			ComponentSubjectIds: []int64{5},
+		},
+		{
+			Id: gproto.Int64(12), Level: gproto.Int32(3), Japanese: gproto.String("口"),
+			Meanings: meaning("Mouth"), Kanji: &proto.Kanji{},
+			ComponentSubjectIds: []int64{2},
+		},
+	}
+}
+
+// writeFile writes the subjects, deleting the given IDs, and returns the file.
+func writeFile(t *testing.T, blockSize int, subjects []*proto.Subject, deleted ...int64) []byte {
+	t.Helper()
+	w := NewWriter()
+	w.BlockSize = blockSize
+	if err := w.AddAll(subjects); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range deleted {
+		w.Delete(id)
+	}
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// roundTrip writes the subjects, deleting the given IDs, and reads the file
+// back.
+func roundTrip(t *testing.T, blockSize int, subjects []*proto.Subject, deleted ...int64) *Reader {
+	t.Helper()
+	r, err := NewReader(writeFile(t, blockSize, subjects, deleted...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		blockSize int
+	}{
+		{"plain", 0},
+		{"one subject per block", 1},
+		{"several subjects per block", 40},
+		{"one block", 1 << 20},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := testSubjects()
+			r := roundTrip(t, tc.blockSize, want, 3, 7)
+			if got := r.Compressed(); got != (tc.blockSize > 0) {
+				t.Errorf("Compressed() = %v", got)
+			}
+
+			got, err := r.Subjects()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("got %d subjects, want %d", len(got), len(want))
+			}
+			for i := range want {
+				if !gproto.Equal(got[i], want[i]) {
+					t.Errorf("subject %d: got %v, want %v", want[i].GetId(), got[i], want[i])
+				}
+			}
+
+			if got, want := r.IDs(), []int64{1, 2, 5, 9, 12}; !reflect.DeepEqual(got, want) {
+				t.Errorf("IDs() = %v, want %v", got, want)
+			}
+			if got, want := r.ByLevelAndType(1, proto.Subject_RADICAL), []int64{1, 2}; !reflect.DeepEqual(got, want) {
+				t.Errorf("level 1 radicals = %v, want %v", got, want)
+			}
+			if got, want := r.ByType(proto.Subject_KANJI), []int64{5, 12}; !reflect.DeepEqual(got, want) {
+				t.Errorf("kanji = %v, want %v", got, want)
+			}
+			if level, ok := r.Level(9); !ok || level != 2 {
+				t.Errorf("Level(9) = %d, %v, want 2", level, ok)
+			}
+		})
+	}
+}
+
+func TestRoundTripGapsAndDeleted(t *testing.T) {
+	for _, blockSize := range []int{0, 1, 40} {
+		r := roundTrip(t, blockSize, testSubjects(), 3, 7)
+
+		for _, id := range []int64{3, 7} {
+			if !r.Deleted(id) || r.Has(id) {
+				t.Errorf("block size %d: subject %d isn't deleted", blockSize, id)
+			}
+			if _, err := r.Subject(id); !errors.Is(err, ErrDeleted) {
+				t.Errorf("block size %d: Subject(%d) = %v, want ErrDeleted", blockSize, id, err)
+			}
+			if _, ok := r.Level(id); ok {
+				t.Errorf("block size %d: deleted subject %d has a level", blockSize, id)
+			}
+		}
+		for _, id := range []int64{0, 4, 6, 8, 10, 11, 13, 100} {
+			if r.Has(id) || r.Deleted(id) {
+				t.Errorf("block size %d: gap %d has a subject", blockSize, id)
+			}
+			if _, err := r.Subject(id); !errors.Is(err, ErrNotFound) {
+				t.Errorf("block size %d: Subject(%d) = %v, want ErrNotFound", blockSize, id, err)
+			}
+		}
+		if got, want := r.Header.DeletedSubjectIds, []int32{3, 7}; !reflect.DeepEqual(got, want) {
+			t.Errorf("block size %d: deleted_subject_ids = %v, want %v", blockSize, got, want)
+		}
+	}
+}
+
+func TestRoundTripDeletedPastEnd(t *testing.T) {
+	// Deleted IDs don't have to be below the highest subject ID.
+	r := roundTrip(t, 0, testSubjects(), 50)
+	if !r.Deleted(50) {
+		t.Errorf("subject 50 isn't deleted")
+	}
+	if _, err := r.Subject(50); !errors.Is(err, ErrDeleted) {
+		t.Errorf("Subject(50) = %v, want ErrDeleted", err)
+	}
+}
+
+func TestAddedAndDeleted(t *testing.T) {
+	w := NewWriter()
+	if err := w.AddAll(testSubjects()); err != nil {
+		t.Fatal(err)
+	}
+	w.Delete(5)
+	err := w.Write(&bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "subject 5 is both added and deleted") {
+		t.Errorf("got error %v, want subject 5 is both added and deleted", err)
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		s    *proto.Subject
+		want string
+	}{
+		{"no ID", &proto.Subject{Level: gproto.Int32(1), Radical: &proto.Radical{}}, "has no ID"},
+		{"no level", &proto.Subject{Id: gproto.Int64(20), Radical: &proto.Radical{}}, "has no level"},
+		{"no type", &proto.Subject{Id: gproto.Int64(20), Level: gproto.Int32(1)}, "has no radical, kanji or vocabulary"},
+		{"duplicate", testSubjects()[0], "is added twice"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := NewWriter()
+			if err := w.AddAll(testSubjects()); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Add(tc.s); err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got error %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
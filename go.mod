@@ -4,5 +4,8 @@ go 1.20
 
 require (
 	github.com/golang/protobuf v1.5.0
+	golang.org/x/image v0.20.0
 	google.golang.org/protobuf v1.33.0
 )
+
+require golang.org/x/text v0.18.0 // indirect
@@ -41,7 +41,10 @@ let package = Package(name: "WaniKaniAPI",
                                   .copy("Resources/visually-similar-kanji.json"),
                                 ]),
                         .testTarget(name: "WaniKaniAPITests",
-                                    dependencies: ["Hippolyte", "WaniKaniAPI"]),
+                                    dependencies: ["Hippolyte", "WaniKaniAPI"],
+                                    resources: [
+                                      .copy("Resources/markup-vectors.json"),
+                                    ]),
                         .testTarget(name: "WaniKaniAPIProber",
                                     dependencies: ["WaniKaniAPI"]),
                       ])
@@ -305,6 +305,22 @@ public struct TKMKanji: Sendable {
 
   public var visuallySimilarKanjiIds: [Int64] = []
 
+  /// Other kanji with the same primary on'yomi.
+  public var sameReadingKanjiIds: [Int64] = []
+
+  /// The phonetic component this kanji shares with the other kanji in its
+  /// keisei family, which are read the same way.
+  public var phoneticComponent: String {
+    get {return _phoneticComponent ?? String()}
+    set {_phoneticComponent = newValue}
+  }
+  /// Returns true if `phoneticComponent` has been explicitly set.
+  public var hasPhoneticComponent: Bool {return self._phoneticComponent != nil}
+  /// Clears the value of `phoneticComponent`. Subsequent reads from it will return its default value.
+  public mutating func clearPhoneticComponent() {self._phoneticComponent = nil}
+
+  public var phoneticFamilyKanjiIds: [Int64] = []
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
@@ -314,6 +330,7 @@ public struct TKMKanji: Sendable {
   fileprivate var _readingMnemonic: String? = nil
   fileprivate var _readingHint: String? = nil
   fileprivate var _visuallySimilarKanji: String? = nil
+  fileprivate var _phoneticComponent: String? = nil
 }
 
 public struct TKMVocabulary: Sendable {
@@ -1143,9 +1160,104 @@ public struct TKMDataFileHeader: Sendable {
   /// header in the file.
   public var subjectByteOffset: [UInt32] = []
 
+  /// Set if the subjects are stored in compressed blocks.
+  /// subject_byte_offset is then the offset of each subject in its
+  /// decompressed block.
+  public var blocks: TKMDataFileBlocks {
+    get {return _blocks ?? TKMDataFileBlocks()}
+    set {_blocks = newValue}
+  }
+  /// Returns true if `blocks` has been explicitly set.
+  public var hasBlocks: Bool {return self._blocks != nil}
+  /// Clears the value of `blocks`. Subsequent reads from it will return its default value.
+  public mutating func clearBlocks() {self._blocks = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _blocks: TKMDataFileBlocks? = nil
+}
+
+/// The blocks of subjects in a compressed data file.
+public struct TKMDataFileBlocks: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var compression: TKMDataFileBlocks.Compression {
+    get {return _compression ?? .uncompressed}
+    set {_compression = newValue}
+  }
+  /// Returns true if `compression` has been explicitly set.
+  public var hasCompression: Bool {return self._compression != nil}
+  /// Clears the value of `compression`. Subsequent reads from it will return its default value.
+  public mutating func clearCompression() {self._compression = nil}
+
+  /// Offset of each compressed block, starting from the end of the header.
+  public var blockByteOffset: [UInt32] = []
+
+  /// Length of each block once it's decompressed.
+  public var blockLength: [UInt32] = []
+
+  /// The block each subject is in, indexed by subject ID.
+  public var blockBySubject: [UInt32] = []
+
+  /// How many uncompressed bytes the writer put in each block, at least.
+  public var targetBlockSize: UInt32 {
+    get {return _targetBlockSize ?? 0}
+    set {_targetBlockSize = newValue}
+  }
+  /// Returns true if `targetBlockSize` has been explicitly set.
+  public var hasTargetBlockSize: Bool {return self._targetBlockSize != nil}
+  /// Clears the value of `targetBlockSize`. Subsequent reads from it will return its default value.
+  public mutating func clearTargetBlockSize() {self._targetBlockSize = nil}
+
+  /// CRC-32 (IEEE) of each decompressed block.
+  public var blockCrc32: [UInt32] = []
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
+  public enum Compression: SwiftProtobuf.Enum, Swift.CaseIterable {
+    public typealias RawValue = Int
+    case uncompressed // = 0
+
+    /// Raw DEFLATE (RFC 1951) without a zlib header.
+    case deflate // = 1
+    case UNRECOGNIZED(Int)
+
+    public init() {
+      self = .uncompressed
+    }
+
+    public init?(rawValue: Int) {
+      switch rawValue {
+      case 0: self = .uncompressed
+      case 1: self = .deflate
+      default: self = .UNRECOGNIZED(rawValue)
+      }
+    }
+
+    public var rawValue: Int {
+      switch self {
+      case .uncompressed: return 0
+      case .deflate: return 1
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+
+    // The compiler won't synthesize support with the UNRECOGNIZED case.
+    public static let allCases: [TKMDataFileBlocks.Compression] = [
+      .uncompressed,
+      .deflate,
+    ]
+
+  }
+
   public init() {}
+
+  fileprivate var _compression: TKMDataFileBlocks.Compression? = nil
+  fileprivate var _targetBlockSize: UInt32? = nil
 }
 
 public struct TKMSubjectsByLevel: Sendable {
@@ -1164,6 +1276,225 @@ public struct TKMSubjectsByLevel: Sendable {
   public init() {}
 }
 
+/// The changes that turn one data file into another.  Subjects are in ID order.
+public struct TKMDataFileDelta: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Hex-encoded SHA-256 of the whole data file this delta applies to, and of
+  /// the file it produces.
+  public var baseSha256: String {
+    get {return _baseSha256 ?? String()}
+    set {_baseSha256 = newValue}
+  }
+  /// Returns true if `baseSha256` has been explicitly set.
+  public var hasBaseSha256: Bool {return self._baseSha256 != nil}
+  /// Clears the value of `baseSha256`. Subsequent reads from it will return its default value.
+  public mutating func clearBaseSha256() {self._baseSha256 = nil}
+
+  public var resultSha256: String {
+    get {return _resultSha256 ?? String()}
+    set {_resultSha256 = newValue}
+  }
+  /// Returns true if `resultSha256` has been explicitly set.
+  public var hasResultSha256: Bool {return self._resultSha256 != nil}
+  /// Clears the value of `resultSha256`. Subsequent reads from it will return its default value.
+  public mutating func clearResultSha256() {self._resultSha256 = nil}
+
+  /// Subjects that aren't in the base file.
+  public var addedSubjects: [TKMSubject] = []
+
+  /// Subjects that replace the ones with the same IDs in the base file.
+  public var replacedSubjects: [TKMSubject] = []
+
+  /// Subjects in the base file that aren't in the new file.
+  public var deletedSubjectIds: [Int64] = []
+
+  /// The deleted_subject_ids of the new file's header.
+  public var resultDeletedSubjectIds: [Int32] = []
+
+  /// The target_block_size of the new file, if it's compressed.
+  public var resultBlockSize: UInt32 {
+    get {return _resultBlockSize ?? 0}
+    set {_resultBlockSize = newValue}
+  }
+  /// Returns true if `resultBlockSize` has been explicitly set.
+  public var hasResultBlockSize: Bool {return self._resultBlockSize != nil}
+  /// Clears the value of `resultBlockSize`. Subsequent reads from it will return its default value.
+  public mutating func clearResultBlockSize() {self._resultBlockSize = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _baseSha256: String? = nil
+  fileprivate var _resultSha256: String? = nil
+  fileprivate var _resultBlockSize: UInt32? = nil
+}
+
+/// A search index of the subjects in a data file, stored in a sidecar file next
+/// to it so lookups don't have to decode every subject.
+public struct TKMSearchIndex: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Hex-encoded SHA-256 of the whole data file the index was built from, or
+  /// empty if it was built from a list of subjects.
+  public var dataSha256: String {
+    get {return _dataSha256 ?? String()}
+    set {_dataSha256 = newValue}
+  }
+  /// Returns true if `dataSha256` has been explicitly set.
+  public var hasDataSha256: Bool {return self._dataSha256 != nil}
+  /// Clears the value of `dataSha256`. Subsequent reads from it will return its default value.
+  public mutating func clearDataSha256() {self._dataSha256 = nil}
+
+  /// Sorted by term.
+  public var terms: [TKMSearchTerm] = []
+
+  /// Enough about every indexed subject to show it in search results.
+  public var subjects: [TKMSearchSubject] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _dataSha256: String? = nil
+}
+
+/// One normalized term and the subjects it appears in.  The postings are
+/// parallel lists sorted by subject ID, with one entry for each subject.
+public struct TKMSearchTerm: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var term: String {
+    get {return _term ?? String()}
+    set {_term = newValue}
+  }
+  /// Returns true if `term` has been explicitly set.
+  public var hasTerm: Bool {return self._term != nil}
+  /// Clears the value of `term`. Subsequent reads from it will return its default value.
+  public mutating func clearTerm() {self._term = nil}
+
+  public var subjectID: [Int64] = []
+
+  /// The part of the subject the term came from, and how much it counts for.
+  public var field: [TKMSearchTerm.Field] = []
+
+  public var weight: [Float] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum Field: SwiftProtobuf.Enum, Swift.CaseIterable {
+    public typealias RawValue = Int
+    case meaning // = 0
+    case reading // = 1
+    case romaji // = 2
+    case japanese // = 3
+    case slug // = 4
+    case mnemonic // = 5
+    case UNRECOGNIZED(Int)
+
+    public init() {
+      self = .meaning
+    }
+
+    public init?(rawValue: Int) {
+      switch rawValue {
+      case 0: self = .meaning
+      case 1: self = .reading
+      case 2: self = .romaji
+      case 3: self = .japanese
+      case 4: self = .slug
+      case 5: self = .mnemonic
+      default: self = .UNRECOGNIZED(rawValue)
+      }
+    }
+
+    public var rawValue: Int {
+      switch self {
+      case .meaning: return 0
+      case .reading: return 1
+      case .romaji: return 2
+      case .japanese: return 3
+      case .slug: return 4
+      case .mnemonic: return 5
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+
+    // The compiler won't synthesize support with the UNRECOGNIZED case.
+    public static let allCases: [TKMSearchTerm.Field] = [
+      .meaning,
+      .reading,
+      .romaji,
+      .japanese,
+      .slug,
+      .mnemonic,
+    ]
+
+  }
+
+  public init() {}
+
+  fileprivate var _term: String? = nil
+}
+
+public struct TKMSearchSubject: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var id: Int64 {
+    get {return _id ?? 0}
+    set {_id = newValue}
+  }
+  /// Returns true if `id` has been explicitly set.
+  public var hasID: Bool {return self._id != nil}
+  /// Clears the value of `id`. Subsequent reads from it will return its default value.
+  public mutating func clearID() {self._id = nil}
+
+  public var type: TKMSubject.TypeEnum {
+    get {return _type ?? .unknown}
+    set {_type = newValue}
+  }
+  /// Returns true if `type` has been explicitly set.
+  public var hasType: Bool {return self._type != nil}
+  /// Clears the value of `type`. Subsequent reads from it will return its default value.
+  public mutating func clearType() {self._type = nil}
+
+  public var japanese: String {
+    get {return _japanese ?? String()}
+    set {_japanese = newValue}
+  }
+  /// Returns true if `japanese` has been explicitly set.
+  public var hasJapanese: Bool {return self._japanese != nil}
+  /// Clears the value of `japanese`. Subsequent reads from it will return its default value.
+  public mutating func clearJapanese() {self._japanese = nil}
+
+  public var level: Int32 {
+    get {return _level ?? 0}
+    set {_level = newValue}
+  }
+  /// Returns true if `level` has been explicitly set.
+  public var hasLevel: Bool {return self._level != nil}
+  /// Clears the value of `level`. Subsequent reads from it will return its default value.
+  public mutating func clearLevel() {self._level = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _id: Int64? = nil
+  fileprivate var _type: TKMSubject.TypeEnum? = nil
+  fileprivate var _japanese: String? = nil
+  fileprivate var _level: Int32? = nil
+}
+
 public struct TKMLevel: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
@@ -1748,6 +2079,9 @@ extension TKMKanji: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationB
     4: .standard(proto: "reading_hint"),
     10: .standard(proto: "visually_similar_kanji"),
     11: .standard(proto: "visually_similar_kanji_ids"),
+    12: .standard(proto: "same_reading_kanji_ids"),
+    13: .standard(proto: "phonetic_component"),
+    14: .standard(proto: "phonetic_family_kanji_ids"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -1762,6 +2096,9 @@ extension TKMKanji: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationB
       case 4: try { try decoder.decodeSingularStringField(value: &self._readingHint) }()
       case 10: try { try decoder.decodeSingularStringField(value: &self._visuallySimilarKanji) }()
       case 11: try { try decoder.decodeRepeatedInt64Field(value: &self.visuallySimilarKanjiIds) }()
+      case 12: try { try decoder.decodeRepeatedInt64Field(value: &self.sameReadingKanjiIds) }()
+      case 13: try { try decoder.decodeSingularStringField(value: &self._phoneticComponent) }()
+      case 14: try { try decoder.decodeRepeatedInt64Field(value: &self.phoneticFamilyKanjiIds) }()
       default: break
       }
     }
@@ -1790,6 +2127,15 @@ extension TKMKanji: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationB
     if !self.visuallySimilarKanjiIds.isEmpty {
       try visitor.visitPackedInt64Field(value: self.visuallySimilarKanjiIds, fieldNumber: 11)
     }
+    if !self.sameReadingKanjiIds.isEmpty {
+      try visitor.visitPackedInt64Field(value: self.sameReadingKanjiIds, fieldNumber: 12)
+    }
+    try { if let v = self._phoneticComponent {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 13)
+    } }()
+    if !self.phoneticFamilyKanjiIds.isEmpty {
+      try visitor.visitPackedInt64Field(value: self.phoneticFamilyKanjiIds, fieldNumber: 14)
+    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -1800,6 +2146,9 @@ extension TKMKanji: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationB
     if lhs._readingHint != rhs._readingHint {return false}
     if lhs._visuallySimilarKanji != rhs._visuallySimilarKanji {return false}
     if lhs.visuallySimilarKanjiIds != rhs.visuallySimilarKanjiIds {return false}
+    if lhs.sameReadingKanjiIds != rhs.sameReadingKanjiIds {return false}
+    if lhs._phoneticComponent != rhs._phoneticComponent {return false}
+    if lhs.phoneticFamilyKanjiIds != rhs.phoneticFamilyKanjiIds {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
@@ -2521,6 +2870,7 @@ extension TKMDataFileHeader: SwiftProtobuf.Message, SwiftProtobuf._MessageImplem
     4: .standard(proto: "level_by_subject"),
     3: .standard(proto: "deleted_subject_ids"),
     2: .standard(proto: "subject_byte_offset"),
+    5: .same(proto: "blocks"),
   ]
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
@@ -2533,12 +2883,17 @@ extension TKMDataFileHeader: SwiftProtobuf.Message, SwiftProtobuf._MessageImplem
       case 2: try { try decoder.decodeRepeatedUInt32Field(value: &self.subjectByteOffset) }()
       case 3: try { try decoder.decodeRepeatedInt32Field(value: &self.deletedSubjectIds) }()
       case 4: try { try decoder.decodeRepeatedInt32Field(value: &self.levelBySubject) }()
+      case 5: try { try decoder.decodeSingularMessageField(value: &self._blocks) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
     if !self.subjectsByLevel.isEmpty {
       try visitor.visitRepeatedMessageField(value: self.subjectsByLevel, fieldNumber: 1)
     }
@@ -2551,6 +2906,9 @@ extension TKMDataFileHeader: SwiftProtobuf.Message, SwiftProtobuf._MessageImplem
     if !self.levelBySubject.isEmpty {
       try visitor.visitPackedInt32Field(value: self.levelBySubject, fieldNumber: 4)
     }
+    try { if let v = self._blocks {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 5)
+    } }()
     try unknownFields.traverse(visitor: &visitor)
   }
 
@@ -2559,11 +2917,85 @@ extension TKMDataFileHeader: SwiftProtobuf.Message, SwiftProtobuf._MessageImplem
     if lhs.levelBySubject != rhs.levelBySubject {return false}
     if lhs.deletedSubjectIds != rhs.deletedSubjectIds {return false}
     if lhs.subjectByteOffset != rhs.subjectByteOffset {return false}
+    if lhs._blocks != rhs._blocks {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension TKMDataFileBlocks: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".DataFileBlocks"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "compression"),
+    2: .standard(proto: "block_byte_offset"),
+    3: .standard(proto: "block_length"),
+    4: .standard(proto: "block_by_subject"),
+    5: .standard(proto: "target_block_size"),
+    6: .standard(proto: "block_crc32"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self._compression) }()
+      case 2: try { try decoder.decodeRepeatedUInt32Field(value: &self.blockByteOffset) }()
+      case 3: try { try decoder.decodeRepeatedUInt32Field(value: &self.blockLength) }()
+      case 4: try { try decoder.decodeRepeatedUInt32Field(value: &self.blockBySubject) }()
+      case 5: try { try decoder.decodeSingularUInt32Field(value: &self._targetBlockSize) }()
+      case 6: try { try decoder.decodeRepeatedFixed32Field(value: &self.blockCrc32) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._compression {
+      try visitor.visitSingularEnumField(value: v, fieldNumber: 1)
+    } }()
+    if !self.blockByteOffset.isEmpty {
+      try visitor.visitPackedUInt32Field(value: self.blockByteOffset, fieldNumber: 2)
+    }
+    if !self.blockLength.isEmpty {
+      try visitor.visitPackedUInt32Field(value: self.blockLength, fieldNumber: 3)
+    }
+    if !self.blockBySubject.isEmpty {
+      try visitor.visitPackedUInt32Field(value: self.blockBySubject, fieldNumber: 4)
+    }
+    try { if let v = self._targetBlockSize {
+      try visitor.visitSingularUInt32Field(value: v, fieldNumber: 5)
+    } }()
+    if !self.blockCrc32.isEmpty {
+      try visitor.visitPackedFixed32Field(value: self.blockCrc32, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: TKMDataFileBlocks, rhs: TKMDataFileBlocks) -> Bool {
+    if lhs._compression != rhs._compression {return false}
+    if lhs.blockByteOffset != rhs.blockByteOffset {return false}
+    if lhs.blockLength != rhs.blockLength {return false}
+    if lhs.blockBySubject != rhs.blockBySubject {return false}
+    if lhs._targetBlockSize != rhs._targetBlockSize {return false}
+    if lhs.blockCrc32 != rhs.blockCrc32 {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
+extension TKMDataFileBlocks.Compression: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "UNCOMPRESSED"),
+    1: .same(proto: "DEFLATE"),
+  ]
+}
+
 extension TKMSubjectsByLevel: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".SubjectsByLevel"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
@@ -2608,6 +3040,245 @@ extension TKMSubjectsByLevel: SwiftProtobuf.Message, SwiftProtobuf._MessageImple
   }
 }
 
+extension TKMDataFileDelta: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".DataFileDelta"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .standard(proto: "base_sha256"),
+    2: .standard(proto: "result_sha256"),
+    3: .standard(proto: "added_subjects"),
+    4: .standard(proto: "replaced_subjects"),
+    5: .standard(proto: "deleted_subject_ids"),
+    6: .standard(proto: "result_deleted_subject_ids"),
+    7: .standard(proto: "result_block_size"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self._baseSha256) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self._resultSha256) }()
+      case 3: try { try decoder.decodeRepeatedMessageField(value: &self.addedSubjects) }()
+      case 4: try { try decoder.decodeRepeatedMessageField(value: &self.replacedSubjects) }()
+      case 5: try { try decoder.decodeRepeatedInt64Field(value: &self.deletedSubjectIds) }()
+      case 6: try { try decoder.decodeRepeatedInt32Field(value: &self.resultDeletedSubjectIds) }()
+      case 7: try { try decoder.decodeSingularUInt32Field(value: &self._resultBlockSize) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._baseSha256 {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 1)
+    } }()
+    try { if let v = self._resultSha256 {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 2)
+    } }()
+    if !self.addedSubjects.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.addedSubjects, fieldNumber: 3)
+    }
+    if !self.replacedSubjects.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.replacedSubjects, fieldNumber: 4)
+    }
+    if !self.deletedSubjectIds.isEmpty {
+      try visitor.visitPackedInt64Field(value: self.deletedSubjectIds, fieldNumber: 5)
+    }
+    if !self.resultDeletedSubjectIds.isEmpty {
+      try visitor.visitPackedInt32Field(value: self.resultDeletedSubjectIds, fieldNumber: 6)
+    }
+    try { if let v = self._resultBlockSize {
+      try visitor.visitSingularUInt32Field(value: v, fieldNumber: 7)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: TKMDataFileDelta, rhs: TKMDataFileDelta) -> Bool {
+    if lhs._baseSha256 != rhs._baseSha256 {return false}
+    if lhs._resultSha256 != rhs._resultSha256 {return false}
+    if lhs.addedSubjects != rhs.addedSubjects {return false}
+    if lhs.replacedSubjects != rhs.replacedSubjects {return false}
+    if lhs.deletedSubjectIds != rhs.deletedSubjectIds {return false}
+    if lhs.resultDeletedSubjectIds != rhs.resultDeletedSubjectIds {return false}
+    if lhs._resultBlockSize != rhs._resultBlockSize {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension TKMSearchIndex: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SearchIndex"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .standard(proto: "data_sha256"),
+    2: .same(proto: "terms"),
+    3: .same(proto: "subjects"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self._dataSha256) }()
+      case 2: try { try decoder.decodeRepeatedMessageField(value: &self.terms) }()
+      case 3: try { try decoder.decodeRepeatedMessageField(value: &self.subjects) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._dataSha256 {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 1)
+    } }()
+    if !self.terms.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.terms, fieldNumber: 2)
+    }
+    if !self.subjects.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.subjects, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: TKMSearchIndex, rhs: TKMSearchIndex) -> Bool {
+    if lhs._dataSha256 != rhs._dataSha256 {return false}
+    if lhs.terms != rhs.terms {return false}
+    if lhs.subjects != rhs.subjects {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension TKMSearchTerm: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SearchTerm"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "term"),
+    2: .standard(proto: "subject_id"),
+    3: .same(proto: "field"),
+    4: .same(proto: "weight"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self._term) }()
+      case 2: try { try decoder.decodeRepeatedInt64Field(value: &self.subjectID) }()
+      case 3: try { try decoder.decodeRepeatedEnumField(value: &self.field) }()
+      case 4: try { try decoder.decodeRepeatedFloatField(value: &self.weight) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._term {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 1)
+    } }()
+    if !self.subjectID.isEmpty {
+      try visitor.visitPackedInt64Field(value: self.subjectID, fieldNumber: 2)
+    }
+    if !self.field.isEmpty {
+      try visitor.visitPackedEnumField(value: self.field, fieldNumber: 3)
+    }
+    if !self.weight.isEmpty {
+      try visitor.visitPackedFloatField(value: self.weight, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: TKMSearchTerm, rhs: TKMSearchTerm) -> Bool {
+    if lhs._term != rhs._term {return false}
+    if lhs.subjectID != rhs.subjectID {return false}
+    if lhs.field != rhs.field {return false}
+    if lhs.weight != rhs.weight {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension TKMSearchTerm.Field: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    0: .same(proto: "MEANING"),
+    1: .same(proto: "READING"),
+    2: .same(proto: "ROMAJI"),
+    3: .same(proto: "JAPANESE"),
+    4: .same(proto: "SLUG"),
+    5: .same(proto: "MNEMONIC"),
+  ]
+}
+
+extension TKMSearchSubject: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SearchSubject"
+  public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
+    1: .same(proto: "id"),
+    2: .same(proto: "type"),
+    3: .same(proto: "japanese"),
+    4: .same(proto: "level"),
+  ]
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self._id) }()
+      case 2: try { try decoder.decodeSingularEnumField(value: &self._type) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self._japanese) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self._level) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._id {
+      try visitor.visitSingularInt64Field(value: v, fieldNumber: 1)
+    } }()
+    try { if let v = self._type {
+      try visitor.visitSingularEnumField(value: v, fieldNumber: 2)
+    } }()
+    try { if let v = self._japanese {
+      try visitor.visitSingularStringField(value: v, fieldNumber: 3)
+    } }()
+    try { if let v = self._level {
+      try visitor.visitSingularInt32Field(value: v, fieldNumber: 4)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: TKMSearchSubject, rhs: TKMSearchSubject) -> Bool {
+    if lhs._id != rhs._id {return false}
+    if lhs._type != rhs._type {return false}
+    if lhs._japanese != rhs._japanese {return false}
+    if lhs._level != rhs._level {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
 extension TKMLevel: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
   public static let protoMessageName: String = _protobuf_package + ".Level"
   public static let _protobuf_nameMap: SwiftProtobuf._NameMap = [
@@ -65,4 +65,24 @@ class MarkupFormatterTest: XCTestCase {
       link_url: "bar"
     """))
   }
+
+  func testSharedVectors() throws {
+    // markup/markup_test.go checks the Go port against the same vectors.  Whether
+    // the input is malformed is only checked there, since this doesn't report
+    // errors.
+    let url = Bundle.module.url(forResource: "markup-vectors", withExtension: "json")!
+    let data = try Data(contentsOf: url)
+    let vectors = try JSONSerialization.jsonObject(with: data) as! [[String: Any]]
+    XCTAssertFalse(vectors.isEmpty)
+
+    for vector in vectors {
+      let name = vector["name"] as! String
+      let input = vector["input"] as! String
+      let want = try (vector["output"] as! [Any]).map { output -> TKMFormattedText in
+        let json = try JSONSerialization.data(withJSONObject: output)
+        return try TKMFormattedText(jsonUTF8Data: json)
+      }
+      XCTAssertEqual(parseFormattedText(input), want, name)
+    }
+  }
 }
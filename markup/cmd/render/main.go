@@ -0,0 +1,86 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command render parses WaniKani markup from its arguments, or from stdin if
+// there are none, and renders it as HTML, Markdown, ANSI or plain text.
+// Problems with the markup are printed to stderr.
+//
+//	render -format ansi 'A <radical>ground</radical> with a <kanji>mouth</kanji>'
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/markup"
+)
+
+var (
+	format   = flag.String("format", "text", "Output format: html, markdown, ansi or text")
+	showURLs = flag.Bool("show_urls", false, "Show link URLs after the link text in ansi and text output")
+	strict   = flag.Bool("strict", false, "Exit with an error if the markup is malformed")
+)
+
+func renderer() (markup.Renderer, error) {
+	switch *format {
+	case "html":
+		return markup.NewHTML(), nil
+	case "markdown":
+		return markup.NewMarkdown(), nil
+	case "ansi":
+		r := markup.NewANSI()
+		r.ShowURLs = *showURLs
+		return r, nil
+	case "text":
+		return &markup.PlainText{ShowURLs: *showURLs}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q", *format)
+}
+
+func run() error {
+	r, err := renderer()
+	if err != nil {
+		return err
+	}
+
+	text := strings.Join(flag.Args(), " ")
+	if flag.NArg() == 0 {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		text = string(b)
+	}
+
+	parsed, err := markup.Parse(text)
+	if err != nil {
+		if *strict {
+			return fmt.Errorf("malformed markup:\n%w", err)
+		}
+		fmt.Fprintln(os.Stderr, err)
+	}
+	fmt.Println(r.Render(parsed))
+	return nil
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package markup parses the tags WaniKani uses in mnemonics, like
+// <radical>, <ja> and <a href="...">, into FormattedText runs.  It's a port of
+// parseFormattedText in the app's MarkupFormatter.swift and gives the same
+// runs for the same input.
+package markup
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// The same expression as kTagRE in MarkupFormatter.swift.
+var tagRe = regexp.MustCompile(`(?i)` +
+	`([^\[<]*)` +
+	`(?:[\[<]` +
+	`(/?(?:vocabulary|reading|ja|jp|kanji|radical|b|em|i|strong|kan|a))` +
+	`(?: href="([^"]+)"[^>]*)?` +
+	`[\]>])`)
+
+var formats = map[string]proto.FormattedText_Format{
+	"radical":    proto.FormattedText_RADICAL,
+	"ja":         proto.FormattedText_JAPANESE,
+	"jp":         proto.FormattedText_JAPANESE,
+	"reading":    proto.FormattedText_READING,
+	"vocabulary": proto.FormattedText_VOCABULARY,
+	"i":          proto.FormattedText_ITALIC,
+	"kanji":      proto.FormattedText_KANJI,
+	"kan":        proto.FormattedText_KANJI,
+	"b":          proto.FormattedText_BOLD,
+	"em":         proto.FormattedText_BOLD,
+	"strong":     proto.FormattedText_BOLD,
+	"a":          proto.FormattedText_LINK,
+}
+
+// SyntaxError is something wrong with the markup.  Offset is in bytes from
+// the start of the text.
+type SyntaxError struct {
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("offset %d: %s", e.Offset, e.Message)
+}
+
+// Parse turns marked-up text into runs of formatted text.  Formats nest, so
+// the text in "<ja>a<b>b</b></ja>" has JAPANESE and then JAPANESE and BOLD,
+// and text inside a link has its URL.
+//
+// Malformed markup gives the same runs as the app would show, along with an
+// error joining a SyntaxError for each problem: closing tags that don't match
+// the open one, tags that are never closed, links with no href, text that the
+// app drops because it has a < or [ that isn't part of a tag, and leading
+// whitespace.
+func Parse(text string) ([]*proto.FormattedText, error) {
+	var ret []*proto.FormattedText
+	var errs []error
+	fail := func(offset int, format string, args ...interface{}) {
+		errs = append(errs, &SyntaxError{offset, fmt.Sprintf(format, args...)})
+	}
+
+	// The app matches tags in the text as given, but only as far as the length
+	// of the text with surrounding whitespace trimmed, and takes the runs from
+	// the trimmed text at the same UTF-16 offsets.  With leading whitespace
+	// those offsets are off by its length, so this does the same.
+	trimmed := utf16.Encode([]rune(strings.TrimSpace(text)))
+	search := string(utf16.Decode(utf16.Encode([]rune(text))[:len(trimmed)]))
+	if strings.TrimLeftFunc(text, unicode.IsSpace) != text {
+		fail(0, "leading whitespace moves every run the app shows")
+	}
+	units := make([]int, len(search)+1)
+	n := 0
+	for i, r := range search {
+		units[i] = n
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	units[len(search)] = n
+	sub := func(start, end int) string {
+		return string(utf16.Decode(trimmed[units[start]:units[end]]))
+	}
+
+	type open struct {
+		tag    string
+		offset int
+	}
+	var formatStack []proto.FormattedText_Format
+	var tagStack []open
+	var linkURLStack []string
+	lastIndex := 0
+
+	for _, m := range tagRe.FindAllStringSubmatchIndex(search, -1) {
+		if m[0] != lastIndex {
+			fail(lastIndex, "%q is dropped because it has a < or [ that isn't a tag", sub(lastIndex, m[0]))
+		}
+		lastIndex = m[1]
+		t := sub(m[2], m[3])
+		tag := strings.ToLower(sub(m[4], m[5]))
+		tagOffset := m[4] - 1
+		raw := sub(tagOffset, m[1])
+
+		// Add this text.
+		if t != "" {
+			ft := &proto.FormattedText{
+				Format: append([]proto.FormattedText_Format(nil), formatStack...),
+				Text:   gproto.String(t),
+			}
+			if len(linkURLStack) != 0 {
+				ft.LinkUrl = gproto.String(linkURLStack[len(linkURLStack)-1])
+			}
+			ret = append(ret, ft)
+		}
+
+		// Add the next format tag.
+		if strings.HasPrefix(tag, "/") {
+			if len(formatStack) == 0 {
+				fail(tagOffset, "closing tag %s with no open tag", raw)
+				continue
+			}
+			last := formatStack[len(formatStack)-1]
+			formatStack = formatStack[:len(formatStack)-1]
+			o := tagStack[len(tagStack)-1]
+			tagStack = tagStack[:len(tagStack)-1]
+			if last == proto.FormattedText_LINK {
+				linkURLStack = linkURLStack[:len(linkURLStack)-1]
+			}
+			if f, ok := formats[tag[1:]]; !ok || f != last {
+				fail(tagOffset, "closing tag %s doesn't match %s at offset %d", raw, o.tag, o.offset)
+			}
+			continue
+		}
+
+		f, ok := formats[tag]
+		if !ok {
+			fail(tagOffset, "unknown tag %s", raw)
+			continue
+		}
+		formatStack = append(formatStack, f)
+		tagStack = append(tagStack, open{raw, tagOffset})
+		if f == proto.FormattedText_LINK {
+			href := ""
+			if m[6] != -1 {
+				href = sub(m[6], m[7])
+			} else {
+				fail(tagOffset, "link has no href")
+			}
+			linkURLStack = append(linkURLStack, href)
+		}
+	}
+
+	// Add the leftover text.  Like the app, this doesn't get a link URL even if
+	// a link is still open, but an open link is reported below anyway.
+	if units[lastIndex] != len(trimmed) {
+		ret = append(ret, &proto.FormattedText{
+			Format: append([]proto.FormattedText_Format(nil), formatStack...),
+			Text:   gproto.String(string(utf16.Decode(trimmed[units[lastIndex]:]))),
+		})
+	}
+
+	for _, o := range tagStack {
+		fail(o.offset, "%s is never closed", o.tag)
+	}
+	return ret, errors.Join(errs...)
+}
@@ -0,0 +1,97 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markup
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// MarkupFormatterTest.testSharedVectors checks the Swift parser against the
+// same file, so the two parsers are known to agree.
+const vectorsFile = "../ios/WaniKaniAPI/Tests/WaniKaniAPITests/Resources/markup-vectors.json"
+
+type vector struct {
+	Name      string            `json:"name"`
+	Input     string            `json:"input"`
+	Malformed bool              `json:"malformed"`
+	Output    []json.RawMessage `json:"output"`
+}
+
+func format(text []*proto.FormattedText) string {
+	s := "["
+	for i, t := range text {
+		if i != 0 {
+			s += ", "
+		}
+		s += protojson.MarshalOptions{}.Format(t)
+	}
+	return s + "]"
+}
+
+func equal(a, b []*proto.FormattedText) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !gproto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSharedVectors(t *testing.T) {
+	data, err := os.ReadFile(vectorsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vs []vector
+	if err := json.Unmarshal(data, &vs); err != nil {
+		t.Fatalf("%s: %v", vectorsFile, err)
+	}
+	if len(vs) == 0 {
+		t.Fatalf("%s has no vectors", vectorsFile)
+	}
+
+	for _, v := range vs {
+		t.Run(v.Name, func(t *testing.T) {
+			var want []*proto.FormattedText
+			for _, o := range v.Output {
+				ft := &proto.FormattedText{}
+				if err := protojson.Unmarshal(o, ft); err != nil {
+					t.Fatalf("bad output: %v", err)
+				}
+				want = append(want, ft)
+			}
+
+			got, err := Parse(v.Input)
+			switch {
+			case v.Malformed && err == nil:
+				t.Errorf("no error for malformed input")
+			case !v.Malformed && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !equal(got, want) {
+				t.Errorf("got %s, want %s", format(got), format(want))
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markup
+
+import (
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+// Renderer turns formatted text into something to display.  The renderers in
+// this package are styled by maps from each format to how it's shown, which
+// can be changed after they're created, and anything else can be plugged in
+// by implementing this.
+type Renderer interface {
+	Render(text []*proto.FormattedText) string
+}
+
+// link is the URL of a run's link, or empty if it isn't in one.
+func link(t *proto.FormattedText) string {
+	for _, f := range t.Format {
+		if f == proto.FormattedText_LINK {
+			return t.GetLinkUrl()
+		}
+	}
+	return ""
+}
+
+// linkSpans splits text into spans of consecutive runs in the same link, or
+// not in any link, so a link is rendered once even if the formats inside it
+// change.
+func linkSpans(text []*proto.FormattedText) [][]*proto.FormattedText {
+	var ret [][]*proto.FormattedText
+	for i, t := range text {
+		if i == 0 || link(t) != link(text[i-1]) {
+			ret = append(ret, nil)
+		}
+		ret[len(ret)-1] = append(ret[len(ret)-1], t)
+	}
+	return ret
+}
+
+// styles returns what a style map gives for each of a run's formats, without
+// empty or repeated ones.
+func styles(t *proto.FormattedText, m map[proto.FormattedText_Format]string) []string {
+	var ret []string
+	seen := map[string]bool{}
+	for _, f := range t.Format {
+		if s := m[f]; s != "" && !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func copyStyles(m map[proto.FormattedText_Format]string) map[proto.FormattedText_Format]string {
+	ret := map[proto.FormattedText_Format]string{}
+	for k, v := range m {
+		ret[k] = v
+	}
+	return ret
+}
+
+// HTML renders text as HTML, with each run in a span whose classes are given
+// by its formats, and links as <a> elements.  Links whose URLs could run
+// script are left out, but their text isn't.
+type HTML struct {
+	// Classes maps each format to a CSS class.  Formats with no class don't
+	// get one, and runs with no classes aren't put in a span.
+	Classes map[proto.FormattedText_Format]string
+}
+
+// DefaultHTMLClasses are the classes styled by HTMLStylesheet.
+var DefaultHTMLClasses = map[proto.FormattedText_Format]string{
+	proto.FormattedText_RADICAL:     "markup-radical",
+	proto.FormattedText_KANJI:       "markup-kanji",
+	proto.FormattedText_JAPANESE:    "markup-japanese",
+	proto.FormattedText_READING:     "markup-reading",
+	proto.FormattedText_VOCABULARY:  "markup-vocabulary",
+	proto.FormattedText_ITALIC:      "markup-italic",
+	proto.FormattedText_BOLD:        "markup-bold",
+	proto.FormattedText_LINK:        "markup-link",
+	proto.FormattedText_APPRENTICE:  "markup-apprentice",
+	proto.FormattedText_GURU:        "markup-guru",
+	proto.FormattedText_MASTER:      "markup-master",
+	proto.FormattedText_ENLIGHTENED: "markup-enlightened",
+}
+
+// HTMLStylesheet styles DefaultHTMLClasses in the app's colours.
+const HTMLStylesheet = `.markup-radical { color: #000000; background-color: #D6F1FF; }
+.markup-kanji { color: #000000; background-color: #FFD6F1; }
+.markup-vocabulary { color: #000000; background-color: #F1D6FF; }
+.markup-reading { color: #FFFFFF; background-color: #555555; }
+.markup-japanese { font-family: "Hiragino Sans", "Hiragino Kaku Gothic ProN", "Noto Sans JP", sans-serif; }
+.markup-italic { font-style: italic; }
+.markup-bold { font-weight: bold; }
+.markup-link { color: #007AFF; text-decoration: underline; }
+.markup-apprentice { color: #FFFFFF; background-color: #DE0094; }
+.markup-guru { color: #FFFFFF; background-color: #872B9E; }
+.markup-master { color: #FFFFFF; background-color: #294DDB; }
+.markup-enlightened { color: #FFFFFF; background-color: #0094DE; }
+`
+
+// NewHTML returns an HTML renderer using DefaultHTMLClasses.
+func NewHTML() *HTML {
+	return &HTML{Classes: copyStyles(DefaultHTMLClasses)}
+}
+
+// safeURL returns whether a link URL is safe to put in an href, or in a
+// Markdown link that might be turned into one.  Relative URLs
+// are allowed, but of absolute ones only http, https and mailto are.
+func safeURL(u string) bool {
+	p, err := url.Parse(u)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(p.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+func (h *HTML) Render(text []*proto.FormattedText) string {
+	var sb strings.Builder
+	for _, span := range linkSpans(text) {
+		u := link(span[0])
+		if u != "" && safeURL(u) {
+			sb.WriteString(`<a href="` + html.EscapeString(u) + `">`)
+		} else {
+			u = ""
+		}
+		for _, t := range span {
+			classes := styles(t, h.Classes)
+			if len(classes) == 0 {
+				sb.WriteString(html.EscapeString(t.GetText()))
+				continue
+			}
+			sb.WriteString(`<span class="` + html.EscapeString(strings.Join(classes, " ")) + `">`)
+			sb.WriteString(html.EscapeString(t.GetText()))
+			sb.WriteString("</span>")
+		}
+		if u != "" {
+			sb.WriteString("</a>")
+		}
+	}
+	return sb.String()
+}
+
+// Markdown renders text as CommonMark, with formats as emphasis and links as
+// inline links.  Like HTML, links with unsafe URLs are left out.
+type Markdown struct {
+	// Delimiters maps each format to the markup put either side of text with
+	// that format, like "**" for bold.  Formats with no delimiter aren't shown.
+	Delimiters map[proto.FormattedText_Format]string
+}
+
+// DefaultMarkdownDelimiters make radicals, kanji, vocabulary, readings and
+// SRS stages bold, since Markdown has no colours.
+var DefaultMarkdownDelimiters = map[proto.FormattedText_Format]string{
+	proto.FormattedText_RADICAL:     "**",
+	proto.FormattedText_KANJI:       "**",
+	proto.FormattedText_READING:     "**",
+	proto.FormattedText_VOCABULARY:  "**",
+	proto.FormattedText_ITALIC:      "*",
+	proto.FormattedText_BOLD:        "**",
+	proto.FormattedText_APPRENTICE:  "**",
+	proto.FormattedText_GURU:        "**",
+	proto.FormattedText_MASTER:      "**",
+	proto.FormattedText_ENLIGHTENED: "**",
+}
+
+// NewMarkdown returns a Markdown renderer using DefaultMarkdownDelimiters.
+func NewMarkdown() *Markdown {
+	return &Markdown{Delimiters: copyStyles(DefaultMarkdownDelimiters)}
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `#`, `\#`, `|`, `\|`)
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Markdown) Render(text []*proto.FormattedText) string {
+	var sb strings.Builder
+	for _, span := range linkSpans(text) {
+		// Runs with the same delimiters are joined, so "**a****b**" is written
+		// as "**ab**".
+		var inner strings.Builder
+		for i := 0; i < len(span); {
+			delims := styles(span[i], m.Delimiters)
+			var s string
+			for ; i < len(span) && sameStrings(styles(span[i], m.Delimiters), delims); i++ {
+				s += span[i].GetText()
+			}
+			inner.WriteString(m.emphasize(s, delims))
+		}
+
+		if u := link(span[0]); u != "" && safeURL(u) {
+			if strings.ContainsAny(u, " ()<>") {
+				u = "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(u) + ">"
+			}
+			sb.WriteString("[" + inner.String() + "](" + u + ")")
+		} else {
+			sb.WriteString(inner.String())
+		}
+	}
+	return sb.String()
+}
+
+// emphasize escapes s and puts delimiters around it.  Emphasis can't start or
+// end with a space in CommonMark, so surrounding spaces are moved outside.
+func (m *Markdown) emphasize(s string, delims []string) string {
+	body := strings.TrimSpace(s)
+	if len(delims) == 0 || body == "" {
+		return markdownEscaper.Replace(s)
+	}
+	start := strings.Index(s, body)
+	var sb strings.Builder
+	sb.WriteString(s[:start])
+	for _, d := range delims {
+		sb.WriteString(d)
+	}
+	sb.WriteString(markdownEscaper.Replace(body))
+	for i := len(delims) - 1; i >= 0; i-- {
+		sb.WriteString(delims[i])
+	}
+	sb.WriteString(s[start+len(body):])
+	return sb.String()
+}
+
+// ANSI renders text for a terminal, with formats as ANSI escape codes.
+// Control characters other than tabs and newlines are removed from the text
+// and URLs, so they can't move the cursor or start escape sequences of their
+// own.
+type ANSI struct {
+	// Codes maps each format to SGR parameters, like "1" for bold or
+	// "38;2;255;0;0" for red text.  Formats with no code aren't shown.
+	Codes map[proto.FormattedText_Format]string
+
+	// ShowURLs writes each link's URL in brackets after it.
+	ShowURLs bool
+}
+
+// DefaultANSICodes use the app's dark mode colours for radicals, kanji and
+// vocabulary, and its SRS stage colours, in 24-bit colour.
+var DefaultANSICodes = map[proto.FormattedText_Format]string{
+	proto.FormattedText_RADICAL:     "38;2;74;195;255",
+	proto.FormattedText_KANJI:       "38;2;255;74;195",
+	proto.FormattedText_READING:     "97;48;2;85;85;85",
+	proto.FormattedText_VOCABULARY:  "38;2;195;74;255",
+	proto.FormattedText_ITALIC:      "3",
+	proto.FormattedText_BOLD:        "1",
+	proto.FormattedText_LINK:        "4",
+	proto.FormattedText_APPRENTICE:  "97;48;2;222;0;148",
+	proto.FormattedText_GURU:        "97;48;2;135;43;158",
+	proto.FormattedText_MASTER:      "97;48;2;41;77;219",
+	proto.FormattedText_ENLIGHTENED: "97;48;2;0;148;222",
+}
+
+// NewANSI returns an ANSI renderer using DefaultANSICodes that shows URLs.
+func NewANSI() *ANSI {
+	return &ANSI{Codes: copyStyles(DefaultANSICodes), ShowURLs: true}
+}
+
+// stripControls removes the C0 and C1 control characters from s, except tab
+// and newline.
+func stripControls(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r < 0x20 && r != '\t' && r != '\n') || (r >= 0x7f && r <= 0x9f) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func (a *ANSI) Render(text []*proto.FormattedText) string {
+	var sb strings.Builder
+	for _, span := range linkSpans(text) {
+		for _, t := range span {
+			codes := styles(t, a.Codes)
+			if len(codes) == 0 {
+				sb.WriteString(stripControls(t.GetText()))
+				continue
+			}
+			sb.WriteString("\x1b[" + strings.Join(codes, ";") + "m")
+			sb.WriteString(stripControls(t.GetText()))
+			sb.WriteString("\x1b[0m")
+		}
+		if u := link(span[0]); u != "" && a.ShowURLs {
+			sb.WriteString(" (" + stripControls(u) + ")")
+		}
+	}
+	return sb.String()
+}
+
+// PlainText renders just the text, for screen readers and text to speech.
+type PlainText struct {
+	// ShowURLs writes each link's URL in brackets after it.
+	ShowURLs bool
+}
+
+func (p *PlainText) Render(text []*proto.FormattedText) string {
+	var sb strings.Builder
+	for _, span := range linkSpans(text) {
+		for _, t := range span {
+			sb.WriteString(t.GetText())
+		}
+		if u := link(span[0]); u != "" && p.ShowURLs {
+			sb.WriteString(" (" + u + ")")
+		}
+	}
+	return sb.String()
+}
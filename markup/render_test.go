@@ -0,0 +1,181 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markup
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func TestHTMLStylesheetHasEveryClass(t *testing.T) {
+	for f, class := range DefaultHTMLClasses {
+		if !strings.Contains(HTMLStylesheet, "."+class+" {") {
+			t.Errorf("HTMLStylesheet has no rule for %s (%s)", class, f)
+		}
+	}
+}
+
+func TestANSIStripsControlCharacters(t *testing.T) {
+	text := []*proto.FormattedText{
+		{Text: gproto.String("a\x1b]0;title\x07b\tc\n")},
+		{
+			Format: []proto.FormattedText_Format{proto.FormattedText_BOLD},
+			Text:   gproto.String("d\x1b[2Je\u009b31mf\x7f"),
+		},
+		{
+			Format:  []proto.FormattedText_Format{proto.FormattedText_LINK},
+			Text:    gproto.String("link"),
+			LinkUrl: gproto.String("https://example.com/\x1b[8m"),
+		},
+	}
+	r := NewANSI()
+	r.Codes = map[proto.FormattedText_Format]string{proto.FormattedText_BOLD: "1"}
+
+	want := "a]0;titleb\tc\n\x1b[1md[2Je31mf\x1b[0mlink (https://example.com/[8m)"
+	if got := r.Render(text); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func run(text string, formats ...proto.FormattedText_Format) *proto.FormattedText {
+	return &proto.FormattedText{Text: gproto.String(text), Format: formats}
+}
+
+func linkRun(text, url string, formats ...proto.FormattedText_Format) *proto.FormattedText {
+	t := run(text, append([]proto.FormattedText_Format{proto.FormattedText_LINK}, formats...)...)
+	t.LinkUrl = gproto.String(url)
+	return t
+}
+
+func text(runs ...*proto.FormattedText) []*proto.FormattedText {
+	return runs
+}
+
+const (
+	bold    = proto.FormattedText_BOLD
+	italic  = proto.FormattedText_ITALIC
+	kanji   = proto.FormattedText_KANJI
+	reading = proto.FormattedText_READING
+)
+
+func TestSafeURL(t *testing.T) {
+	for _, tc := range []struct {
+		url  string
+		want bool
+	}{
+		{"https://www.wanikani.com/kanji/一", true},
+		{"http://example.com", true},
+		{"HTTPS://example.com", true},
+		{"mailto:hello@tsurukame.app", true},
+		{"/kanji/一", true},
+		{"kanji/一", true},
+		{"javascript:alert(1)", false},
+		{"JavaScript:alert(1)", false},
+		{"data:text/html,<script>alert(1)</script>", false},
+		{"vbscript:msgbox", false},
+		{"file:///etc/passwd", false},
+		{"%zz", false},
+	} {
+		if got := safeURL(tc.url); got != tc.want {
+			t.Errorf("safeURL(%q) = %v, want %v", tc.url, got, tc.want)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text []*proto.FormattedText
+		want string
+	}{
+		{"plain", text(run("Hello")), "Hello"},
+		{"escaped text", text(run(`<b>"Tom" & 'Jerry'</b>`)), "&lt;b&gt;&#34;Tom&#34; &amp; &#39;Jerry&#39;&lt;/b&gt;"},
+		{"formats", text(run("a"), run("b", kanji), run("c", bold, italic)),
+			`a<span class="markup-kanji">b</span><span class="markup-bold markup-italic">c</span>`},
+		{"escaped text in a span", text(run("<x>", kanji)), `<span class="markup-kanji">&lt;x&gt;</span>`},
+		{"link", text(linkRun("WaniKani", "https://www.wanikani.com/")),
+			`<a href="https://www.wanikani.com/"><span class="markup-link">WaniKani</span></a>`},
+		{"escaped href", text(linkRun("x", `https://example.com/?a=1&b="2"`)),
+			`<a href="https://example.com/?a=1&amp;b=&#34;2&#34;"><span class="markup-link">x</span></a>`},
+		{"javascript link", text(linkRun("click", "javascript:alert(1)")), `<span class="markup-link">click</span>`},
+		{"one link across formats", text(linkRun("a", "/x"), linkRun("b", "/x", bold), run("c")),
+			`<a href="/x"><span class="markup-link">a</span><span class="markup-link markup-bold">b</span></a>c`},
+		{"neighbouring links", text(linkRun("a", "/x"), linkRun("b", "/y")),
+			`<a href="/x"><span class="markup-link">a</span></a><a href="/y"><span class="markup-link">b</span></a>`},
+		{"empty", nil, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NewHTML().Render(tc.text); got != tc.want {
+				t.Errorf("got  %s\nwant %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHTMLClasses(t *testing.T) {
+	r := &HTML{Classes: map[proto.FormattedText_Format]string{bold: "b", italic: "b"}}
+	if got, want := r.Render(text(run("x", bold, italic), run("y", kanji))), `<span class="b">x</span>y`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text []*proto.FormattedText
+		want string
+	}{
+		{"plain", text(run("Hello")), "Hello"},
+		{"escaped", text(run("*a* _b_ [c](d) <e> #f |g| `h` \\")), "\\*a\\* \\_b\\_ \\[c\\](d) \\<e\\> \\#f \\|g\\| \\`h\\` \\\\"},
+		{"emphasis", text(run("a "), run("b", italic), run(" c")), "a *b* c"},
+		{"escaped inside emphasis", text(run("2*3", bold)), "**2\\*3**"},
+		{"spaces moved outside emphasis", text(run(" big dog ", kanji)), " **big dog** "},
+		{"only spaces", text(run("  ", bold)), "  "},
+		{"nested", text(run("x", bold, italic)), "***x***"},
+		{"same delimiters are joined", text(run("a", kanji), run("b", reading)), "**ab**"},
+		{"link", text(linkRun("WaniKani", "https://www.wanikani.com/")), "[WaniKani](https://www.wanikani.com/)"},
+		{"link with emphasis", text(linkRun("a", "/x"), linkRun("b", "/x", bold)), "[a**b**](/x)"},
+		{"wrapped URL", text(linkRun("x", "https://example.com/a b")), "[x](<https://example.com/a b>)"},
+		{"wrapped URL with brackets", text(linkRun("x", "https://example.com/(a)<b>")),
+			"[x](<https://example.com/(a)%3Cb%3E>)"},
+		{"javascript link", text(linkRun("click", "javascript:alert(1)")), "click"},
+		{"empty", nil, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NewMarkdown().Render(tc.text); got != tc.want {
+				t.Errorf("got  %q\nwant %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	in := text(run("The "), run("<kanji>", kanji), run(" "), linkRun("site", "javascript:x"), run("."))
+	for _, tc := range []struct {
+		showURLs bool
+		want     string
+	}{
+		{false, "The <kanji> site."},
+		{true, "The <kanji> site (javascript:x)."},
+	} {
+		r := &PlainText{ShowURLs: tc.showURLs}
+		if got := r.Render(in); got != tc.want {
+			t.Errorf("ShowURLs %v: got %q, want %q", tc.showURLs, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Messages used only by the Go tools in this repository.  The app never reads
+// or writes these, so they aren't generated for Swift.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: tooling.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewMistake_Field int32
+
+const (
+	ReviewMistake_UNKNOWN ReviewMistake_Field = 0
+	ReviewMistake_MEANING ReviewMistake_Field = 1
+	ReviewMistake_READING ReviewMistake_Field = 2
+)
+
+// Enum value maps for ReviewMistake_Field.
+var (
+	ReviewMistake_Field_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "MEANING",
+		2: "READING",
+	}
+	ReviewMistake_Field_value = map[string]int32{
+		"UNKNOWN": 0,
+		"MEANING": 1,
+		"READING": 2,
+	}
+)
+
+func (x ReviewMistake_Field) Enum() *ReviewMistake_Field {
+	p := new(ReviewMistake_Field)
+	*p = x
+	return p
+}
+
+func (x ReviewMistake_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewMistake_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_tooling_proto_enumTypes[0].Descriptor()
+}
+
+func (ReviewMistake_Field) Type() protoreflect.EnumType {
+	return &file_tooling_proto_enumTypes[0]
+}
+
+func (x ReviewMistake_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewMistake_Field.Descriptor instead.
+func (ReviewMistake_Field) EnumDescriptor() ([]byte, []int) {
+	return file_tooling_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A wrong answer, for finding the kanji learners confuse with each other.
+// Progress doesn't store what the learner typed, so it's kept alongside.
+type ReviewMistake struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Progress *Progress              `protobuf:"bytes,1,opt,name=progress,proto3,oneof" json:"progress,omitempty"`
+	// The wrong answer, or empty if it wasn't recorded.
+	Answer *string `protobuf:"bytes,2,opt,name=answer,proto3,oneof" json:"answer,omitempty"`
+	// Whether the answer was for the meaning or the reading.  If it isn't set
+	// and both were wrong, answers in kana are taken to be readings.
+	Field         *ReviewMistake_Field `protobuf:"varint,3,opt,name=field,proto3,enum=proto.ReviewMistake_Field,oneof" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewMistake) Reset() {
+	*x = ReviewMistake{}
+	mi := &file_tooling_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewMistake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMistake) ProtoMessage() {}
+
+func (x *ReviewMistake) ProtoReflect() protoreflect.Message {
+	mi := &file_tooling_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMistake.ProtoReflect.Descriptor instead.
+func (*ReviewMistake) Descriptor() ([]byte, []int) {
+	return file_tooling_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewMistake) GetProgress() *Progress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *ReviewMistake) GetAnswer() string {
+	if x != nil && x.Answer != nil {
+		return *x.Answer
+	}
+	return ""
+}
+
+func (x *ReviewMistake) GetField() ReviewMistake_Field {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ReviewMistake_UNKNOWN
+}
+
+var File_tooling_proto protoreflect.FileDescriptor
+
+var file_tooling_proto_rawDesc = string([]byte{
+	0x0a, 0x0d, 0x74, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x77, 0x61, 0x6e, 0x69, 0x6b, 0x61, 0x6e, 0x69,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x02, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x88,
+	0x01, 0x01, 0x22, 0x2e, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45, 0x41, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x76, 0x69, 0x64, 0x73, 0x61, 0x6e, 0x73, 0x6f, 0x6d, 0x65, 0x2f,
+	0x74, 0x73, 0x75, 0x72, 0x75, 0x6b, 0x61, 0x6d, 0x65, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_tooling_proto_rawDescOnce sync.Once
+	file_tooling_proto_rawDescData []byte
+)
+
+func file_tooling_proto_rawDescGZIP() []byte {
+	file_tooling_proto_rawDescOnce.Do(func() {
+		file_tooling_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tooling_proto_rawDesc), len(file_tooling_proto_rawDesc)))
+	})
+	return file_tooling_proto_rawDescData
+}
+
+var file_tooling_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tooling_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tooling_proto_goTypes = []any{
+	(ReviewMistake_Field)(0), // 0: proto.ReviewMistake.Field
+	(*ReviewMistake)(nil),    // 1: proto.ReviewMistake
+	(*Progress)(nil),         // 2: proto.Progress
+}
+var file_tooling_proto_depIdxs = []int32{
+	2, // 0: proto.ReviewMistake.progress:type_name -> proto.Progress
+	0, // 1: proto.ReviewMistake.field:type_name -> proto.ReviewMistake.Field
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tooling_proto_init() }
+func file_tooling_proto_init() {
+	if File_tooling_proto != nil {
+		return
+	}
+	file_wanikani_api_proto_init()
+	file_tooling_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tooling_proto_rawDesc), len(file_tooling_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tooling_proto_goTypes,
+		DependencyIndexes: file_tooling_proto_depIdxs,
+		EnumInfos:         file_tooling_proto_enumTypes,
+		MessageInfos:      file_tooling_proto_msgTypes,
+	}.Build()
+	File_tooling_proto = out.File
+	file_tooling_proto_goTypes = nil
+	file_tooling_proto_depIdxs = nil
+}
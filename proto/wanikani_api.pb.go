@@ -380,6 +380,110 @@ func (FormattedText_Format) EnumDescriptor() ([]byte, []int) {
 	return file_wanikani_api_proto_rawDescGZIP(), []int{10, 0}
 }
 
+type DataFileBlocks_Compression int32
+
+const (
+	DataFileBlocks_UNCOMPRESSED DataFileBlocks_Compression = 0
+	DataFileBlocks_DEFLATE      DataFileBlocks_Compression = 1 // Raw DEFLATE (RFC 1951) without a zlib header.
+)
+
+// Enum value maps for DataFileBlocks_Compression.
+var (
+	DataFileBlocks_Compression_name = map[int32]string{
+		0: "UNCOMPRESSED",
+		1: "DEFLATE",
+	}
+	DataFileBlocks_Compression_value = map[string]int32{
+		"UNCOMPRESSED": 0,
+		"DEFLATE":      1,
+	}
+)
+
+func (x DataFileBlocks_Compression) Enum() *DataFileBlocks_Compression {
+	p := new(DataFileBlocks_Compression)
+	*p = x
+	return p
+}
+
+func (x DataFileBlocks_Compression) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataFileBlocks_Compression) Descriptor() protoreflect.EnumDescriptor {
+	return file_wanikani_api_proto_enumTypes[5].Descriptor()
+}
+
+func (DataFileBlocks_Compression) Type() protoreflect.EnumType {
+	return &file_wanikani_api_proto_enumTypes[5]
+}
+
+func (x DataFileBlocks_Compression) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataFileBlocks_Compression.Descriptor instead.
+func (DataFileBlocks_Compression) EnumDescriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type SearchTerm_Field int32
+
+const (
+	SearchTerm_MEANING  SearchTerm_Field = 0
+	SearchTerm_READING  SearchTerm_Field = 1
+	SearchTerm_ROMAJI   SearchTerm_Field = 2
+	SearchTerm_JAPANESE SearchTerm_Field = 3
+	SearchTerm_SLUG     SearchTerm_Field = 4
+	SearchTerm_MNEMONIC SearchTerm_Field = 5
+)
+
+// Enum value maps for SearchTerm_Field.
+var (
+	SearchTerm_Field_name = map[int32]string{
+		0: "MEANING",
+		1: "READING",
+		2: "ROMAJI",
+		3: "JAPANESE",
+		4: "SLUG",
+		5: "MNEMONIC",
+	}
+	SearchTerm_Field_value = map[string]int32{
+		"MEANING":  0,
+		"READING":  1,
+		"ROMAJI":   2,
+		"JAPANESE": 3,
+		"SLUG":     4,
+		"MNEMONIC": 5,
+	}
+)
+
+func (x SearchTerm_Field) Enum() *SearchTerm_Field {
+	p := new(SearchTerm_Field)
+	*p = x
+	return p
+}
+
+func (x SearchTerm_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchTerm_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_wanikani_api_proto_enumTypes[6].Descriptor()
+}
+
+func (SearchTerm_Field) Type() protoreflect.EnumType {
+	return &file_wanikani_api_proto_enumTypes[6]
+}
+
+func (x SearchTerm_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchTerm_Field.Descriptor instead.
+func (SearchTerm_Field) EnumDescriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{16, 0}
+}
+
 type VoiceActor_Gender int32
 
 const (
@@ -413,11 +517,11 @@ func (x VoiceActor_Gender) String() string {
 }
 
 func (VoiceActor_Gender) Descriptor() protoreflect.EnumDescriptor {
-	return file_wanikani_api_proto_enumTypes[5].Descriptor()
+	return file_wanikani_api_proto_enumTypes[7].Descriptor()
 }
 
 func (VoiceActor_Gender) Type() protoreflect.EnumType {
-	return &file_wanikani_api_proto_enumTypes[5]
+	return &file_wanikani_api_proto_enumTypes[7]
 }
 
 func (x VoiceActor_Gender) Number() protoreflect.EnumNumber {
@@ -426,7 +530,7 @@ func (x VoiceActor_Gender) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VoiceActor_Gender.Descriptor instead.
 func (VoiceActor_Gender) EnumDescriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{15, 0}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type ReviewStatistic_Type int32
@@ -465,11 +569,11 @@ func (x ReviewStatistic_Type) String() string {
 }
 
 func (ReviewStatistic_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_wanikani_api_proto_enumTypes[6].Descriptor()
+	return file_wanikani_api_proto_enumTypes[8].Descriptor()
 }
 
 func (ReviewStatistic_Type) Type() protoreflect.EnumType {
-	return &file_wanikani_api_proto_enumTypes[6]
+	return &file_wanikani_api_proto_enumTypes[8]
 }
 
 func (x ReviewStatistic_Type) Number() protoreflect.EnumNumber {
@@ -478,7 +582,7 @@ func (x ReviewStatistic_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReviewStatistic_Type.Descriptor instead.
 func (ReviewStatistic_Type) EnumDescriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{16, 0}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type Meaning struct {
@@ -671,8 +775,14 @@ type Kanji struct {
 	ReadingHint             *string                `protobuf:"bytes,4,opt,name=reading_hint,json=readingHint,proto3,oneof" json:"reading_hint,omitempty"`
 	VisuallySimilarKanji    *string                `protobuf:"bytes,10,opt,name=visually_similar_kanji,json=visuallySimilarKanji,proto3,oneof" json:"visually_similar_kanji,omitempty"`
 	VisuallySimilarKanjiIds []int64                `protobuf:"varint,11,rep,packed,name=visually_similar_kanji_ids,json=visuallySimilarKanjiIds,proto3" json:"visually_similar_kanji_ids,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// Other kanji with the same primary on'yomi.
+	SameReadingKanjiIds []int64 `protobuf:"varint,12,rep,packed,name=same_reading_kanji_ids,json=sameReadingKanjiIds,proto3" json:"same_reading_kanji_ids,omitempty"`
+	// The phonetic component this kanji shares with the other kanji in its
+	// keisei family, which are read the same way.
+	PhoneticComponent      *string `protobuf:"bytes,13,opt,name=phonetic_component,json=phoneticComponent,proto3,oneof" json:"phonetic_component,omitempty"`
+	PhoneticFamilyKanjiIds []int64 `protobuf:"varint,14,rep,packed,name=phonetic_family_kanji_ids,json=phoneticFamilyKanjiIds,proto3" json:"phonetic_family_kanji_ids,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Kanji) Reset() {
@@ -747,6 +857,27 @@ func (x *Kanji) GetVisuallySimilarKanjiIds() []int64 {
 	return nil
 }
 
+func (x *Kanji) GetSameReadingKanjiIds() []int64 {
+	if x != nil {
+		return x.SameReadingKanjiIds
+	}
+	return nil
+}
+
+func (x *Kanji) GetPhoneticComponent() string {
+	if x != nil && x.PhoneticComponent != nil {
+		return *x.PhoneticComponent
+	}
+	return ""
+}
+
+func (x *Kanji) GetPhoneticFamilyKanjiIds() []int64 {
+	if x != nil {
+		return x.PhoneticFamilyKanjiIds
+	}
+	return nil
+}
+
 type Vocabulary struct {
 	state              protoimpl.MessageState           `protogen:"open.v1"`
 	MeaningExplanation *string                          `protobuf:"bytes,1,opt,name=meaning_explanation,json=meaningExplanation,proto3,oneof" json:"meaning_explanation,omitempty"`
@@ -1412,8 +1543,12 @@ type DataFileHeader struct {
 	// Offset of each encoded Subject message, starting from the end of this
 	// header in the file.
 	SubjectByteOffset []uint32 `protobuf:"varint,2,rep,packed,name=subject_byte_offset,json=subjectByteOffset,proto3" json:"subject_byte_offset,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Set if the subjects are stored in compressed blocks.
+	// subject_byte_offset is then the offset of each subject in its
+	// decompressed block.
+	Blocks        *DataFileBlocks `protobuf:"bytes,5,opt,name=blocks,proto3,oneof" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DataFileHeader) Reset() {
@@ -1474,6 +1609,103 @@ func (x *DataFileHeader) GetSubjectByteOffset() []uint32 {
 	return nil
 }
 
+func (x *DataFileHeader) GetBlocks() *DataFileBlocks {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// The blocks of subjects in a compressed data file.
+type DataFileBlocks struct {
+	state       protoimpl.MessageState      `protogen:"open.v1"`
+	Compression *DataFileBlocks_Compression `protobuf:"varint,1,opt,name=compression,proto3,enum=proto.DataFileBlocks_Compression,oneof" json:"compression,omitempty"`
+	// Offset of each compressed block, starting from the end of the header.
+	BlockByteOffset []uint32 `protobuf:"varint,2,rep,packed,name=block_byte_offset,json=blockByteOffset,proto3" json:"block_byte_offset,omitempty"`
+	// Length of each block once it's decompressed.
+	BlockLength []uint32 `protobuf:"varint,3,rep,packed,name=block_length,json=blockLength,proto3" json:"block_length,omitempty"`
+	// The block each subject is in, indexed by subject ID.
+	BlockBySubject []uint32 `protobuf:"varint,4,rep,packed,name=block_by_subject,json=blockBySubject,proto3" json:"block_by_subject,omitempty"`
+	// How many uncompressed bytes the writer put in each block, at least.
+	TargetBlockSize *uint32 `protobuf:"varint,5,opt,name=target_block_size,json=targetBlockSize,proto3,oneof" json:"target_block_size,omitempty"`
+	// CRC-32 (IEEE) of each decompressed block.
+	BlockCrc32    []uint32 `protobuf:"fixed32,6,rep,packed,name=block_crc32,json=blockCrc32,proto3" json:"block_crc32,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataFileBlocks) Reset() {
+	*x = DataFileBlocks{}
+	mi := &file_wanikani_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataFileBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFileBlocks) ProtoMessage() {}
+
+func (x *DataFileBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_wanikani_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFileBlocks.ProtoReflect.Descriptor instead.
+func (*DataFileBlocks) Descriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DataFileBlocks) GetCompression() DataFileBlocks_Compression {
+	if x != nil && x.Compression != nil {
+		return *x.Compression
+	}
+	return DataFileBlocks_UNCOMPRESSED
+}
+
+func (x *DataFileBlocks) GetBlockByteOffset() []uint32 {
+	if x != nil {
+		return x.BlockByteOffset
+	}
+	return nil
+}
+
+func (x *DataFileBlocks) GetBlockLength() []uint32 {
+	if x != nil {
+		return x.BlockLength
+	}
+	return nil
+}
+
+func (x *DataFileBlocks) GetBlockBySubject() []uint32 {
+	if x != nil {
+		return x.BlockBySubject
+	}
+	return nil
+}
+
+func (x *DataFileBlocks) GetTargetBlockSize() uint32 {
+	if x != nil && x.TargetBlockSize != nil {
+		return *x.TargetBlockSize
+	}
+	return 0
+}
+
+func (x *DataFileBlocks) GetBlockCrc32() []uint32 {
+	if x != nil {
+		return x.BlockCrc32
+	}
+	return nil
+}
+
 type SubjectsByLevel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Radicals      []int64                `protobuf:"varint,1,rep,packed,name=radicals,proto3" json:"radicals,omitempty"`
@@ -1485,7 +1717,7 @@ type SubjectsByLevel struct {
 
 func (x *SubjectsByLevel) Reset() {
 	*x = SubjectsByLevel{}
-	mi := &file_wanikani_api_proto_msgTypes[12]
+	mi := &file_wanikani_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1497,7 +1729,7 @@ func (x *SubjectsByLevel) String() string {
 func (*SubjectsByLevel) ProtoMessage() {}
 
 func (x *SubjectsByLevel) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[12]
+	mi := &file_wanikani_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +1742,7 @@ func (x *SubjectsByLevel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubjectsByLevel.ProtoReflect.Descriptor instead.
 func (*SubjectsByLevel) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{12}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SubjectsByLevel) GetRadicals() []int64 {
@@ -1534,6 +1766,311 @@ func (x *SubjectsByLevel) GetVocabulary() []int64 {
 	return nil
 }
 
+// The changes that turn one data file into another.  Subjects are in ID order.
+type DataFileDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hex-encoded SHA-256 of the whole data file this delta applies to, and of
+	// the file it produces.
+	BaseSha256   *string `protobuf:"bytes,1,opt,name=base_sha256,json=baseSha256,proto3,oneof" json:"base_sha256,omitempty"`
+	ResultSha256 *string `protobuf:"bytes,2,opt,name=result_sha256,json=resultSha256,proto3,oneof" json:"result_sha256,omitempty"`
+	// Subjects that aren't in the base file.
+	AddedSubjects []*Subject `protobuf:"bytes,3,rep,name=added_subjects,json=addedSubjects,proto3" json:"added_subjects,omitempty"`
+	// Subjects that replace the ones with the same IDs in the base file.
+	ReplacedSubjects []*Subject `protobuf:"bytes,4,rep,name=replaced_subjects,json=replacedSubjects,proto3" json:"replaced_subjects,omitempty"`
+	// Subjects in the base file that aren't in the new file.
+	DeletedSubjectIds []int64 `protobuf:"varint,5,rep,packed,name=deleted_subject_ids,json=deletedSubjectIds,proto3" json:"deleted_subject_ids,omitempty"`
+	// The deleted_subject_ids of the new file's header.
+	ResultDeletedSubjectIds []int32 `protobuf:"varint,6,rep,packed,name=result_deleted_subject_ids,json=resultDeletedSubjectIds,proto3" json:"result_deleted_subject_ids,omitempty"`
+	// The target_block_size of the new file, if it's compressed.
+	ResultBlockSize *uint32 `protobuf:"varint,7,opt,name=result_block_size,json=resultBlockSize,proto3,oneof" json:"result_block_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DataFileDelta) Reset() {
+	*x = DataFileDelta{}
+	mi := &file_wanikani_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataFileDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataFileDelta) ProtoMessage() {}
+
+func (x *DataFileDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_wanikani_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataFileDelta.ProtoReflect.Descriptor instead.
+func (*DataFileDelta) Descriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DataFileDelta) GetBaseSha256() string {
+	if x != nil && x.BaseSha256 != nil {
+		return *x.BaseSha256
+	}
+	return ""
+}
+
+func (x *DataFileDelta) GetResultSha256() string {
+	if x != nil && x.ResultSha256 != nil {
+		return *x.ResultSha256
+	}
+	return ""
+}
+
+func (x *DataFileDelta) GetAddedSubjects() []*Subject {
+	if x != nil {
+		return x.AddedSubjects
+	}
+	return nil
+}
+
+func (x *DataFileDelta) GetReplacedSubjects() []*Subject {
+	if x != nil {
+		return x.ReplacedSubjects
+	}
+	return nil
+}
+
+func (x *DataFileDelta) GetDeletedSubjectIds() []int64 {
+	if x != nil {
+		return x.DeletedSubjectIds
+	}
+	return nil
+}
+
+func (x *DataFileDelta) GetResultDeletedSubjectIds() []int32 {
+	if x != nil {
+		return x.ResultDeletedSubjectIds
+	}
+	return nil
+}
+
+func (x *DataFileDelta) GetResultBlockSize() uint32 {
+	if x != nil && x.ResultBlockSize != nil {
+		return *x.ResultBlockSize
+	}
+	return 0
+}
+
+// A search index of the subjects in a data file, stored in a sidecar file next
+// to it so lookups don't have to decode every subject.
+type SearchIndex struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hex-encoded SHA-256 of the whole data file the index was built from, or
+	// empty if it was built from a list of subjects.
+	DataSha256 *string `protobuf:"bytes,1,opt,name=data_sha256,json=dataSha256,proto3,oneof" json:"data_sha256,omitempty"`
+	// Sorted by term.
+	Terms []*SearchTerm `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"`
+	// Enough about every indexed subject to show it in search results.
+	Subjects      []*SearchSubject `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIndex) Reset() {
+	*x = SearchIndex{}
+	mi := &file_wanikani_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIndex) ProtoMessage() {}
+
+func (x *SearchIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_wanikani_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIndex.ProtoReflect.Descriptor instead.
+func (*SearchIndex) Descriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchIndex) GetDataSha256() string {
+	if x != nil && x.DataSha256 != nil {
+		return *x.DataSha256
+	}
+	return ""
+}
+
+func (x *SearchIndex) GetTerms() []*SearchTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SearchIndex) GetSubjects() []*SearchSubject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+// One normalized term and the subjects it appears in.  The postings are
+// parallel lists sorted by subject ID, with one entry for each subject.
+type SearchTerm struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Term      *string                `protobuf:"bytes,1,opt,name=term,proto3,oneof" json:"term,omitempty"`
+	SubjectId []int64                `protobuf:"varint,2,rep,packed,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// The part of the subject the term came from, and how much it counts for.
+	Field         []SearchTerm_Field `protobuf:"varint,3,rep,packed,name=field,proto3,enum=proto.SearchTerm_Field" json:"field,omitempty"`
+	Weight        []float32          `protobuf:"fixed32,4,rep,packed,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTerm) Reset() {
+	*x = SearchTerm{}
+	mi := &file_wanikani_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTerm) ProtoMessage() {}
+
+func (x *SearchTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_wanikani_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTerm.ProtoReflect.Descriptor instead.
+func (*SearchTerm) Descriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchTerm) GetTerm() string {
+	if x != nil && x.Term != nil {
+		return *x.Term
+	}
+	return ""
+}
+
+func (x *SearchTerm) GetSubjectId() []int64 {
+	if x != nil {
+		return x.SubjectId
+	}
+	return nil
+}
+
+func (x *SearchTerm) GetField() []SearchTerm_Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *SearchTerm) GetWeight() []float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+type SearchSubject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type          *Subject_Type          `protobuf:"varint,2,opt,name=type,proto3,enum=proto.Subject_Type,oneof" json:"type,omitempty"`
+	Japanese      *string                `protobuf:"bytes,3,opt,name=japanese,proto3,oneof" json:"japanese,omitempty"`
+	Level         *int32                 `protobuf:"varint,4,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSubject) Reset() {
+	*x = SearchSubject{}
+	mi := &file_wanikani_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSubject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSubject) ProtoMessage() {}
+
+func (x *SearchSubject) ProtoReflect() protoreflect.Message {
+	mi := &file_wanikani_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSubject.ProtoReflect.Descriptor instead.
+func (*SearchSubject) Descriptor() ([]byte, []int) {
+	return file_wanikani_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchSubject) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *SearchSubject) GetType() Subject_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Subject_UNKNOWN
+}
+
+func (x *SearchSubject) GetJapanese() string {
+	if x != nil && x.Japanese != nil {
+		return *x.Japanese
+	}
+	return ""
+}
+
+func (x *SearchSubject) GetLevel() int32 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
 type Level struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *int64                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
@@ -1550,7 +2087,7 @@ type Level struct {
 
 func (x *Level) Reset() {
 	*x = Level{}
-	mi := &file_wanikani_api_proto_msgTypes[13]
+	mi := &file_wanikani_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +2099,7 @@ func (x *Level) String() string {
 func (*Level) ProtoMessage() {}
 
 func (x *Level) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[13]
+	mi := &file_wanikani_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +2112,7 @@ func (x *Level) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Level.ProtoReflect.Descriptor instead.
 func (*Level) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{13}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Level) GetId() int64 {
@@ -1643,7 +2180,7 @@ type DeprecatedMnemonicFile struct {
 
 func (x *DeprecatedMnemonicFile) Reset() {
 	*x = DeprecatedMnemonicFile{}
-	mi := &file_wanikani_api_proto_msgTypes[14]
+	mi := &file_wanikani_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1655,7 +2192,7 @@ func (x *DeprecatedMnemonicFile) String() string {
 func (*DeprecatedMnemonicFile) ProtoMessage() {}
 
 func (x *DeprecatedMnemonicFile) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[14]
+	mi := &file_wanikani_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +2205,7 @@ func (x *DeprecatedMnemonicFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeprecatedMnemonicFile.ProtoReflect.Descriptor instead.
 func (*DeprecatedMnemonicFile) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{14}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeprecatedMnemonicFile) GetSubjects() []*DeprecatedMnemonicFile_Subject {
@@ -1690,7 +2227,7 @@ type VoiceActor struct {
 
 func (x *VoiceActor) Reset() {
 	*x = VoiceActor{}
-	mi := &file_wanikani_api_proto_msgTypes[15]
+	mi := &file_wanikani_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1702,7 +2239,7 @@ func (x *VoiceActor) String() string {
 func (*VoiceActor) ProtoMessage() {}
 
 func (x *VoiceActor) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[15]
+	mi := &file_wanikani_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,7 +2252,7 @@ func (x *VoiceActor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoiceActor.ProtoReflect.Descriptor instead.
 func (*VoiceActor) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{15}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VoiceActor) GetId() int64 {
@@ -1768,7 +2305,7 @@ type ReviewStatistic struct {
 
 func (x *ReviewStatistic) Reset() {
 	*x = ReviewStatistic{}
-	mi := &file_wanikani_api_proto_msgTypes[16]
+	mi := &file_wanikani_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1780,7 +2317,7 @@ func (x *ReviewStatistic) String() string {
 func (*ReviewStatistic) ProtoMessage() {}
 
 func (x *ReviewStatistic) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[16]
+	mi := &file_wanikani_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +2330,7 @@ func (x *ReviewStatistic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewStatistic.ProtoReflect.Descriptor instead.
 func (*ReviewStatistic) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{16}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReviewStatistic) GetId() int64 {
@@ -1904,7 +2441,7 @@ type Vocabulary_Sentence struct {
 
 func (x *Vocabulary_Sentence) Reset() {
 	*x = Vocabulary_Sentence{}
-	mi := &file_wanikani_api_proto_msgTypes[17]
+	mi := &file_wanikani_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1916,7 +2453,7 @@ func (x *Vocabulary_Sentence) String() string {
 func (*Vocabulary_Sentence) ProtoMessage() {}
 
 func (x *Vocabulary_Sentence) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[17]
+	mi := &file_wanikani_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2493,7 @@ type Vocabulary_PronunciationAudio struct {
 
 func (x *Vocabulary_PronunciationAudio) Reset() {
 	*x = Vocabulary_PronunciationAudio{}
-	mi := &file_wanikani_api_proto_msgTypes[18]
+	mi := &file_wanikani_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1968,7 +2505,7 @@ func (x *Vocabulary_PronunciationAudio) String() string {
 func (*Vocabulary_PronunciationAudio) ProtoMessage() {}
 
 func (x *Vocabulary_PronunciationAudio) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[18]
+	mi := &file_wanikani_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2545,7 @@ type DeprecatedMnemonicFile_Subject struct {
 
 func (x *DeprecatedMnemonicFile_Subject) Reset() {
 	*x = DeprecatedMnemonicFile_Subject{}
-	mi := &file_wanikani_api_proto_msgTypes[19]
+	mi := &file_wanikani_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2020,7 +2557,7 @@ func (x *DeprecatedMnemonicFile_Subject) String() string {
 func (*DeprecatedMnemonicFile_Subject) ProtoMessage() {}
 
 func (x *DeprecatedMnemonicFile_Subject) ProtoReflect() protoreflect.Message {
-	mi := &file_wanikani_api_proto_msgTypes[19]
+	mi := &file_wanikani_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2570,7 @@ func (x *DeprecatedMnemonicFile_Subject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeprecatedMnemonicFile_Subject.ProtoReflect.Descriptor instead.
 func (*DeprecatedMnemonicFile_Subject) Descriptor() ([]byte, []int) {
-	return file_wanikani_api_proto_rawDescGZIP(), []int{14, 0}
+	return file_wanikani_api_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *DeprecatedMnemonicFile_Subject) GetId() int32 {
@@ -2100,7 +2637,7 @@ var file_wanikani_api_proto_rawDesc = string([]byte{
 	0x65, 0x64, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42, 0x1b, 0x0a, 0x19, 0x5f,
 	0x68, 0x61, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6d,
 	0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
-	0x08, 0x06, 0x10, 0x07, 0x22, 0xb4, 0x03, 0x0a, 0x05, 0x4b, 0x61, 0x6e, 0x6a, 0x69, 0x12, 0x2e,
+	0x08, 0x06, 0x10, 0x07, 0x22, 0xef, 0x04, 0x0a, 0x05, 0x4b, 0x61, 0x6e, 0x6a, 0x69, 0x12, 0x2e,
 	0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
 	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e,
 	0x69, 0x6e, 0x67, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x26,
@@ -2119,388 +2656,490 @@ var file_wanikani_api_proto_rawDesc = string([]byte{
 	0x75, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x6b, 0x61,
 	0x6e, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x03, 0x52, 0x17, 0x76,
 	0x69, 0x73, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x4b, 0x61,
-	0x6e, 0x6a, 0x69, 0x49, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11,
-	0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x63, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69,
-	0x6e, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x76, 0x69, 0x73, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x5f,
-	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x4a, 0x04, 0x08,
-	0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a,
-	0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0xa8, 0x07, 0x0a, 0x0a,
-	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x13, 0x6d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x34, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c,
-	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x12, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x6e,
-	0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x46, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x70, 0x65,
-	0x65, 0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73,
-	0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x6e, 0x75,
-	0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x05, 0x61,
-	0x75, 0x64, 0x69, 0x6f, 0x1a, 0x63, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x1f, 0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x1a, 0x71, 0x0a, 0x12, 0x50, 0x72, 0x6f,
-	0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12,
-	0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
-	0x52, 0x0c, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xd5, 0x02, 0x0a,
-	0x0c, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x0b, 0x0a,
-	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
-	0x55, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x4c, 0x10,
-	0x02, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56,
-	0x45, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x43, 0x48, 0x49,
-	0x44, 0x41, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x05, 0x12,
-	0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
-	0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x4f, 0x44, 0x41, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10,
-	0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x41, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56,
-	0x45, 0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49,
-	0x56, 0x45, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x0a,
-	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x44, 0x56, 0x45, 0x52, 0x42, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x55, 0x52, 0x55, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x0c, 0x12, 0x0a, 0x0a, 0x06, 0x50,
-	0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x50, 0x45,
-	0x52, 0x5f, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x52,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x4a, 0x45,
-	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x45, 0x52, 0x10, 0x12, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4e, 0x4f, 0x55,
-	0x4e, 0x10, 0x13, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4e, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x14, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
-	0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x0a, 0x14,
-	0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07,
-	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x8b, 0x05, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x6e, 0x6a, 0x69, 0x49, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x13, 0x73, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x4b, 0x61, 0x6e, 0x6a, 0x69, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x11, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x74, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x19, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x5f, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x16, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x4b, 0x61, 0x6e, 0x6a, 0x69, 0x49, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6e, 0x65,
+	0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x76, 0x69, 0x73, 0x75, 0x61,
+	0x6c, 0x6c, 0x79, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x6b, 0x61, 0x6e, 0x6a,
+	0x69, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04,
+	0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09,
+	0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0xa8, 0x07, 0x0a, 0x0a, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x13, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x13, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x70,
+	0x61, 0x72, 0x74, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x73, 0x4f, 0x66, 0x53, 0x70, 0x65,
+	0x65, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x1a,
+	0x63, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x6a,
+	0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x07, 0x65, 0x6e, 0x67, 0x6c, 0x69, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x67,
+	0x6c, 0x69, 0x73, 0x68, 0x1a, 0x71, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6e, 0x75, 0x6e, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x0e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x22, 0xd5, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x74,
+	0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x49, 0x4e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x56, 0x45, 0x52,
+	0x42, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x43, 0x48, 0x49, 0x44, 0x41, 0x4e, 0x5f, 0x56,
+	0x45, 0x52, 0x42, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a,
+	0x47, 0x4f, 0x44, 0x41, 0x4e, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c,
+	0x4e, 0x41, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x08, 0x12, 0x0f,
+	0x0a, 0x0b, 0x49, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x09, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x44, 0x56, 0x45, 0x52, 0x42, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x52, 0x55, 0x5f,
+	0x56, 0x45, 0x52, 0x42, 0x10, 0x0c, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58,
+	0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x55,
+	0x4e, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x0f, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x10, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4a, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10,
+	0x12, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4e, 0x4f, 0x55, 0x4e, 0x10, 0x13, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x4f, 0x4e, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x42,
+	0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x22, 0x8b, 0x05, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x13, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73,
+	0x6c, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x6d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d,
+	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x61,
+	0x6d, 0x61, 0x6c, 0x67, 0x61, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x16, 0x61,
+	0x6d, 0x61, 0x6c, 0x67, 0x61, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x48, 0x05, 0x52, 0x07, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x61, 0x6e, 0x6a,
+	0x69, 0x48, 0x06, 0x52, 0x05, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x48, 0x07, 0x52, 0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x88, 0x01, 0x01, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41,
+	0x44, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x4e, 0x4a, 0x49,
+	0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x43, 0x41, 0x42, 0x55, 0x4c, 0x41, 0x52, 0x59,
+	0x10, 0x03, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
+	0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6b, 0x61, 0x6e, 0x6a, 0x69,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x22,
+	0xa7, 0x04, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52,
+	0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x73,
+	0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x0e, 0x73, 0x72, 0x73, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x08, 0x52, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x12, 0x69, 0x73, 0x5f, 0x6b, 0x61, 0x6e, 0x61, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x76,
+	0x6f, 0x63, 0x61, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x0f, 0x69, 0x73,
+	0x4b, 0x61, 0x6e, 0x61, 0x4f, 0x6e, 0x6c, 0x79, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x88, 0x01, 0x01,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x69, 0x73, 0x5f, 0x6b, 0x61, 0x6e, 0x61, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x22, 0xd2, 0x03, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
+	0x08, 0x69, 0x73, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x6d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x57, 0x72, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x13, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x11, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77,
+	0x72, 0x6f, 0x6e, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x5f, 0x6c, 0x65,
+	0x73, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77,
+	0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x82,
+	0x02, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x61, 0x74, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x4a, 0x04, 0x08,
+	0x06, 0x10, 0x07, 0x22, 0x88, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x21, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x1d, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x13, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x11, 0x76, 0x61,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x24, 0x0a, 0x22, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x76, 0x61, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xc5,
+	0x02, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74,
+	0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x22, 0xaf,
+	0x01, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41, 0x44, 0x49, 0x43, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x4e, 0x4a, 0x49, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x4a, 0x41, 0x50, 0x41, 0x4e, 0x45, 0x53, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x43,
+	0x41, 0x42, 0x55, 0x4c, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x54, 0x41,
+	0x4c, 0x49, 0x43, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4c, 0x44, 0x10, 0x07, 0x12,
+	0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x50, 0x50,
+	0x52, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x45, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x55, 0x52,
+	0x55, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x0b, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x45, 0x4e, 0x45, 0x44, 0x10, 0x0c,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x11, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x79,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x48, 0x00,
+	0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xf9, 0x02, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x79, 0x5f, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2f, 0x0a, 0x11,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x72, 0x63, 0x33, 0x32, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x07, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x72, 0x63, 0x33, 0x32, 0x22, 0x2c,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x63, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x05, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x22, 0xa9, 0x03, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x61,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0b, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x3b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x10, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a,
+	0x1a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x17, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x11, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x22, 0xe9, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54,
+	0x65, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x72, 0x6d, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x53, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0b, 0x0a, 0x07, 0x4d,
+	0x45, 0x41, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x41, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x4f, 0x4d, 0x41, 0x4a, 0x49, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x41, 0x50, 0x41, 0x4e, 0x45, 0x53, 0x45, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x4c, 0x55, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4e, 0x45,
+	0x4d, 0x4f, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x6a, 0x65,
 	0x63, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e,
+	0x65, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x86, 0x03, 0x0a, 0x05, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
 	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x2a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x15,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73,
-	0x12, 0x38, 0x0a, 0x18, 0x61, 0x6d, 0x61, 0x6c, 0x67, 0x61, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x16, 0x61, 0x6d, 0x61, 0x6c, 0x67, 0x61, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x61,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x48, 0x05, 0x52, 0x07, 0x72,
-	0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x05, 0x6b, 0x61, 0x6e,
-	0x6a, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4b, 0x61, 0x6e, 0x6a, 0x69, 0x48, 0x06, 0x52, 0x05, 0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x88,
-	0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
-	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x48, 0x07, 0x52, 0x0a, 0x76, 0x6f, 0x63,
-	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x88, 0x01, 0x01, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x52, 0x41, 0x44, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
-	0x4b, 0x41, 0x4e, 0x4a, 0x49, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x43, 0x41, 0x42,
-	0x55, 0x4c, 0x41, 0x52, 0x59, 0x10, 0x03, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6c, 0x75,
-	0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75,
-	0x72, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6a, 0x61, 0x70, 0x61, 0x6e, 0x65, 0x73, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f,
-	0x6b, 0x61, 0x6e, 0x6a, 0x69, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x79, 0x22, 0xa7, 0x04, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x48, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0b, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x05, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x2d, 0x0a, 0x10, 0x73, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x0e, 0x73, 0x72,
-	0x73, 0x53, 0x74, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x20, 0x0a, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x07, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x20, 0x0a, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x08, 0x52, 0x08, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x6b, 0x61, 0x6e, 0x61, 0x5f, 0x6f,
-	0x6e, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x09, 0x52, 0x0f, 0x69, 0x73, 0x4b, 0x61, 0x6e, 0x61, 0x4f, 0x6e, 0x6c, 0x79, 0x56, 0x6f, 0x63,
-	0x61, 0x62, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x72, 0x73, 0x5f, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x69, 0x73, 0x5f, 0x6b,
-	0x61, 0x6e, 0x61, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x22, 0xd2,
-	0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x6d,
-	0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f,
-	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c,
-	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
-	0x20, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x03, 0x52, 0x0a, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
-	0x13, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x11, 0x6d, 0x65,
-	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72,
-	0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x06, 0x52, 0x11, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x65, 0x61, 0x6e,
-	0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x69, 0x73, 0x5f, 0x6c, 0x65, 0x73, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x73,
-	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6d, 0x65, 0x61, 0x6e,
-	0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x6f, 0x6e,
-	0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08,
-	0x02, 0x10, 0x03, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x4d, 0x61, 0x74,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x01, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x0b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x29, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x6f, 0x6e,
-	0x79, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
-	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74,
-	0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
-	0x74, 0x65, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x88, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a,
-	0x21, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x1d, 0x6d, 0x61, 0x78, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x42, 0x79, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0a, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x73,
-	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x12, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x07, 0x52, 0x11, 0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x24,
-	0x0a, 0x22, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x67, 0x72, 0x61,
-	0x6e, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x64, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f,
-	0x76, 0x61, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65,
-	0x64, 0x54, 0x65, 0x78, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x65,
-	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c,
-	0x88, 0x01, 0x01, 0x22, 0xaf, 0x01, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52,
-	0x41, 0x44, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x4e, 0x4a,
-	0x49, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x41, 0x50, 0x41, 0x4e, 0x45, 0x53, 0x45, 0x10,
-	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e,
-	0x0a, 0x0a, 0x56, 0x4f, 0x43, 0x41, 0x42, 0x55, 0x4c, 0x41, 0x52, 0x59, 0x10, 0x05, 0x12, 0x0a,
-	0x0a, 0x06, 0x49, 0x54, 0x41, 0x4c, 0x49, 0x43, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f,
-	0x4c, 0x44, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x08, 0x12, 0x0e,
-	0x0a, 0x0a, 0x41, 0x50, 0x50, 0x52, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x45, 0x10, 0x09, 0x12, 0x08,
-	0x0a, 0x04, 0x47, 0x55, 0x52, 0x55, 0x10, 0x0a, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54,
-	0x45, 0x52, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x45,
-	0x4e, 0x45, 0x44, 0x10, 0x0c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0xde, 0x01, 0x0a, 0x0e,
-	0x44, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x42,
-	0x0a, 0x11, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x0f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x62, 0x79, 0x5f, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x11, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x42, 0x79, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x63, 0x0a, 0x0f,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x08, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6b,
-	0x61, 0x6e, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x6b, 0x61, 0x6e, 0x6a,
-	0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
-	0x79, 0x22, 0x86, 0x03, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x13, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x61,
-	0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x48, 0x02, 0x52, 0x0b, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x20, 0x0a, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x05, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x0a, 0x75, 0x6e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75,
-	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x44,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x63, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x7f, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x1d, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65,
-	0x64, 0x54, 0x65, 0x78, 0x74, 0x52, 0x1b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64,
-	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x56, 0x6f,
-	0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
-	0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x01, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x22, 0x2b, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d,
-	0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10,
-	0x02, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x07, 0x0a,
-	0x0f, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02,
-	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0e,
-	0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x10,
-	0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
-	0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x06, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x14, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
-	0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x88, 0x01,
-	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x08, 0x52, 0x0e, 0x72, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x30, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x09, 0x52, 0x10, 0x72, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x31, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78,
-	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0a, 0x52,
-	0x10, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6b, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x0b, 0x52, 0x14, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12,
-	0x32, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0c, 0x52, 0x11, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x0d, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x88, 0x01, 0x01,
-	0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x41, 0x44, 0x49, 0x43, 0x41, 0x4c,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x4e, 0x4a, 0x49, 0x10, 0x02, 0x12, 0x0e, 0x0a,
-	0x0a, 0x56, 0x4f, 0x43, 0x41, 0x42, 0x55, 0x4c, 0x41, 0x52, 0x59, 0x10, 0x03, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f,
-	0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42,
-	0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
-	0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42, 0x19, 0x0a, 0x17,
-	0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6b, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x35, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x76, 0x69, 0x64, 0x73, 0x61, 0x6e, 0x73, 0x6f, 0x6d, 0x65,
-	0x2f, 0x74, 0x73, 0x75, 0x72, 0x75, 0x6b, 0x61, 0x6d, 0x65, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0xa2, 0x02, 0x03, 0x54, 0x4b, 0x4d, 0xba, 0x02, 0x03, 0x54, 0x4b, 0x4d, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0b, 0x61, 0x62, 0x61, 0x6e,
+	0x64, 0x6f, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x07, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a,
+	0x7f, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x58, 0x0a, 0x1d, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70,
+	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x52, 0x1b, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64,
+	0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x6f, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x01,
+	0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x2b, 0x0a, 0x06, 0x47,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x02, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x07, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x01, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x48,
+	0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x6d, 0x65,
+	0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e,
+	0x67, 0x4d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x16, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52,
+	0x14, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x09, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x0a, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d,
+	0x61, 0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x16, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0b, 0x52, 0x14, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x0c, 0x52, 0x11, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0d, 0x52, 0x06, 0x68, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x22, 0x3b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x41, 0x44, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x41, 0x4e,
+	0x4a, 0x49, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x4f, 0x43, 0x41, 0x42, 0x55, 0x4c, 0x41,
+	0x52, 0x59, 0x10, 0x03, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42,
+	0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x35, 0x5a, 0x27,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x76, 0x69, 0x64,
+	0x73, 0x61, 0x6e, 0x73, 0x6f, 0x6d, 0x65, 0x2f, 0x74, 0x73, 0x75, 0x72, 0x75, 0x6b, 0x61, 0x6d,
+	0x65, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2, 0x02, 0x03, 0x54, 0x4b, 0x4d, 0xba, 0x02, 0x03,
+	0x54, 0x4b, 0x4d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -2515,61 +3154,76 @@ func file_wanikani_api_proto_rawDescGZIP() []byte {
 	return file_wanikani_api_proto_rawDescData
 }
 
-var file_wanikani_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_wanikani_api_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_wanikani_api_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_wanikani_api_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_wanikani_api_proto_goTypes = []any{
 	(Meaning_Type)(0),                      // 0: proto.Meaning.Type
 	(Reading_Type)(0),                      // 1: proto.Reading.Type
 	(Vocabulary_PartOfSpeech)(0),           // 2: proto.Vocabulary.PartOfSpeech
 	(Subject_Type)(0),                      // 3: proto.Subject.Type
 	(FormattedText_Format)(0),              // 4: proto.FormattedText.Format
-	(VoiceActor_Gender)(0),                 // 5: proto.VoiceActor.Gender
-	(ReviewStatistic_Type)(0),              // 6: proto.ReviewStatistic.Type
-	(*Meaning)(nil),                        // 7: proto.Meaning
-	(*Reading)(nil),                        // 8: proto.Reading
-	(*Radical)(nil),                        // 9: proto.Radical
-	(*Kanji)(nil),                          // 10: proto.Kanji
-	(*Vocabulary)(nil),                     // 11: proto.Vocabulary
-	(*Subject)(nil),                        // 12: proto.Subject
-	(*Assignment)(nil),                     // 13: proto.Assignment
-	(*Progress)(nil),                       // 14: proto.Progress
-	(*StudyMaterials)(nil),                 // 15: proto.StudyMaterials
-	(*User)(nil),                           // 16: proto.User
-	(*FormattedText)(nil),                  // 17: proto.FormattedText
-	(*DataFileHeader)(nil),                 // 18: proto.DataFileHeader
-	(*SubjectsByLevel)(nil),                // 19: proto.SubjectsByLevel
-	(*Level)(nil),                          // 20: proto.Level
-	(*DeprecatedMnemonicFile)(nil),         // 21: proto.DeprecatedMnemonicFile
-	(*VoiceActor)(nil),                     // 22: proto.VoiceActor
-	(*ReviewStatistic)(nil),                // 23: proto.ReviewStatistic
-	(*Vocabulary_Sentence)(nil),            // 24: proto.Vocabulary.Sentence
-	(*Vocabulary_PronunciationAudio)(nil),  // 25: proto.Vocabulary.PronunciationAudio
-	(*DeprecatedMnemonicFile_Subject)(nil), // 26: proto.DeprecatedMnemonicFile.Subject
+	(DataFileBlocks_Compression)(0),        // 5: proto.DataFileBlocks.Compression
+	(SearchTerm_Field)(0),                  // 6: proto.SearchTerm.Field
+	(VoiceActor_Gender)(0),                 // 7: proto.VoiceActor.Gender
+	(ReviewStatistic_Type)(0),              // 8: proto.ReviewStatistic.Type
+	(*Meaning)(nil),                        // 9: proto.Meaning
+	(*Reading)(nil),                        // 10: proto.Reading
+	(*Radical)(nil),                        // 11: proto.Radical
+	(*Kanji)(nil),                          // 12: proto.Kanji
+	(*Vocabulary)(nil),                     // 13: proto.Vocabulary
+	(*Subject)(nil),                        // 14: proto.Subject
+	(*Assignment)(nil),                     // 15: proto.Assignment
+	(*Progress)(nil),                       // 16: proto.Progress
+	(*StudyMaterials)(nil),                 // 17: proto.StudyMaterials
+	(*User)(nil),                           // 18: proto.User
+	(*FormattedText)(nil),                  // 19: proto.FormattedText
+	(*DataFileHeader)(nil),                 // 20: proto.DataFileHeader
+	(*DataFileBlocks)(nil),                 // 21: proto.DataFileBlocks
+	(*SubjectsByLevel)(nil),                // 22: proto.SubjectsByLevel
+	(*DataFileDelta)(nil),                  // 23: proto.DataFileDelta
+	(*SearchIndex)(nil),                    // 24: proto.SearchIndex
+	(*SearchTerm)(nil),                     // 25: proto.SearchTerm
+	(*SearchSubject)(nil),                  // 26: proto.SearchSubject
+	(*Level)(nil),                          // 27: proto.Level
+	(*DeprecatedMnemonicFile)(nil),         // 28: proto.DeprecatedMnemonicFile
+	(*VoiceActor)(nil),                     // 29: proto.VoiceActor
+	(*ReviewStatistic)(nil),                // 30: proto.ReviewStatistic
+	(*Vocabulary_Sentence)(nil),            // 31: proto.Vocabulary.Sentence
+	(*Vocabulary_PronunciationAudio)(nil),  // 32: proto.Vocabulary.PronunciationAudio
+	(*DeprecatedMnemonicFile_Subject)(nil), // 33: proto.DeprecatedMnemonicFile.Subject
 }
 var file_wanikani_api_proto_depIdxs = []int32{
 	0,  // 0: proto.Meaning.type:type_name -> proto.Meaning.Type
 	1,  // 1: proto.Reading.type:type_name -> proto.Reading.Type
-	24, // 2: proto.Vocabulary.sentences:type_name -> proto.Vocabulary.Sentence
+	31, // 2: proto.Vocabulary.sentences:type_name -> proto.Vocabulary.Sentence
 	2,  // 3: proto.Vocabulary.parts_of_speech:type_name -> proto.Vocabulary.PartOfSpeech
-	25, // 4: proto.Vocabulary.audio:type_name -> proto.Vocabulary.PronunciationAudio
-	8,  // 5: proto.Subject.readings:type_name -> proto.Reading
-	7,  // 6: proto.Subject.meanings:type_name -> proto.Meaning
-	9,  // 7: proto.Subject.radical:type_name -> proto.Radical
-	10, // 8: proto.Subject.kanji:type_name -> proto.Kanji
-	11, // 9: proto.Subject.vocabulary:type_name -> proto.Vocabulary
+	32, // 4: proto.Vocabulary.audio:type_name -> proto.Vocabulary.PronunciationAudio
+	10, // 5: proto.Subject.readings:type_name -> proto.Reading
+	9,  // 6: proto.Subject.meanings:type_name -> proto.Meaning
+	11, // 7: proto.Subject.radical:type_name -> proto.Radical
+	12, // 8: proto.Subject.kanji:type_name -> proto.Kanji
+	13, // 9: proto.Subject.vocabulary:type_name -> proto.Vocabulary
 	3,  // 10: proto.Assignment.subject_type:type_name -> proto.Subject.Type
-	13, // 11: proto.Progress.assignment:type_name -> proto.Assignment
+	15, // 11: proto.Progress.assignment:type_name -> proto.Assignment
 	4,  // 12: proto.FormattedText.format:type_name -> proto.FormattedText.Format
-	19, // 13: proto.DataFileHeader.subjects_by_level:type_name -> proto.SubjectsByLevel
-	26, // 14: proto.DeprecatedMnemonicFile.subjects:type_name -> proto.DeprecatedMnemonicFile.Subject
-	5,  // 15: proto.VoiceActor.gender:type_name -> proto.VoiceActor.Gender
-	6,  // 16: proto.ReviewStatistic.type:type_name -> proto.ReviewStatistic.Type
-	17, // 17: proto.DeprecatedMnemonicFile.Subject.formatted_deprecated_mnemonic:type_name -> proto.FormattedText
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	22, // 13: proto.DataFileHeader.subjects_by_level:type_name -> proto.SubjectsByLevel
+	21, // 14: proto.DataFileHeader.blocks:type_name -> proto.DataFileBlocks
+	5,  // 15: proto.DataFileBlocks.compression:type_name -> proto.DataFileBlocks.Compression
+	14, // 16: proto.DataFileDelta.added_subjects:type_name -> proto.Subject
+	14, // 17: proto.DataFileDelta.replaced_subjects:type_name -> proto.Subject
+	25, // 18: proto.SearchIndex.terms:type_name -> proto.SearchTerm
+	26, // 19: proto.SearchIndex.subjects:type_name -> proto.SearchSubject
+	6,  // 20: proto.SearchTerm.field:type_name -> proto.SearchTerm.Field
+	3,  // 21: proto.SearchSubject.type:type_name -> proto.Subject.Type
+	33, // 22: proto.DeprecatedMnemonicFile.subjects:type_name -> proto.DeprecatedMnemonicFile.Subject
+	7,  // 23: proto.VoiceActor.gender:type_name -> proto.VoiceActor.Gender
+	8,  // 24: proto.ReviewStatistic.type:type_name -> proto.ReviewStatistic.Type
+	19, // 25: proto.DeprecatedMnemonicFile.Subject.formatted_deprecated_mnemonic:type_name -> proto.FormattedText
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_wanikani_api_proto_init() }
@@ -2588,19 +3242,25 @@ func file_wanikani_api_proto_init() {
 	file_wanikani_api_proto_msgTypes[8].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[9].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[10].OneofWrappers = []any{}
-	file_wanikani_api_proto_msgTypes[13].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[11].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[12].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[14].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[15].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[16].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[17].OneofWrappers = []any{}
 	file_wanikani_api_proto_msgTypes[18].OneofWrappers = []any{}
-	file_wanikani_api_proto_msgTypes[19].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[20].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[21].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[22].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[23].OneofWrappers = []any{}
+	file_wanikani_api_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wanikani_api_proto_rawDesc), len(file_wanikani_api_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   20,
+			NumEnums:      9,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,196 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"fmt"
+)
+
+// ReverseScore decides the score of the reverse edge added by a symmetric
+// closure.
+type ReverseScore string
+
+const (
+	// ReverseSame gives B→A the same score as A→B.
+	ReverseSame ReverseScore = "same"
+
+	// ReverseScaled gives B→A the score of A→B multiplied by ReverseFactor.
+	ReverseScaled ReverseScore = "scaled"
+)
+
+// Derived edges are marked in their SourceScore so they can be told apart from
+// the edges the source listed itself.
+const (
+	DerivedReverse      = "reverse"
+	DerivedSecondDegree = "second_degree"
+)
+
+// ClosurePolicy describes which edges to add to the similarity graph.  The
+// source datasets are directional, so A can list B while B never lists A.
+//
+// Closure is computed separately for each source, and every added edge is
+// attributed to the source whose edges it was derived from.  Derived edges
+// with a score at or below the index's threshold are not added.
+type ClosurePolicy struct {
+	// Symmetric adds B→A for every A→B.
+	Symmetric bool `json:"symmetric"`
+
+	// Reverse and ReverseFactor decide the score of the edges added by
+	// Symmetric.  Defaults to ReverseSame.
+	Reverse       ReverseScore `json:"reverse"`
+	ReverseFactor float32      `json:"reverse_factor"`
+
+	// SecondDegree adds A→C for every A→B→C with a score of
+	// score(A→B) * score(B→C) * Decay.  It considers the edges added by
+	// Symmetric.
+	SecondDegree bool    `json:"second_degree"`
+	Decay        float32 `json:"decay"`
+}
+
+func (p *ClosurePolicy) Validate() error {
+	switch p.Reverse {
+	case "", ReverseSame, ReverseScaled:
+	default:
+		return fmt.Errorf("unknown reverse score policy %q", p.Reverse)
+	}
+	if p.Reverse == ReverseScaled && (p.ReverseFactor <= 0 || p.ReverseFactor > 1) {
+		return fmt.Errorf("reverse_factor must be in (0, 1], got %v", p.ReverseFactor)
+	}
+	if p.SecondDegree && (p.Decay <= 0 || p.Decay > 1) {
+		return fmt.Errorf("decay must be in (0, 1], got %v", p.Decay)
+	}
+	return nil
+}
+
+// ClosureCounts is the number of edges a closure added for one source.
+type ClosureCounts struct {
+	Source       string `json:"source"`
+	Reverse      int    `json:"reverse"`
+	SecondDegree int    `json:"second_degree"`
+}
+
+// edges maps kanji → similar kanji → score for a single source.
+type edges map[string]map[string]float32
+
+func (e edges) add(a, b string, score float32) {
+	if e[a] == nil {
+		e[a] = map[string]float32{}
+	}
+	e[a][b] = score
+}
+
+func (e edges) has(a, b string) bool {
+	_, ok := e[a][b]
+	return ok
+}
+
+// sourceEdges splits the index back into the edges listed by each source.
+func (idx *Index) sourceEdges() map[string]edges {
+	ret := map[string]edges{}
+	for kanji, entries := range idx.data {
+		for _, entry := range entries {
+			for _, s := range entry.Sources {
+				if ret[s.Source] == nil {
+					ret[s.Source] = edges{}
+				}
+				ret[s.Source].add(kanji, entry.Kan, s.Score)
+			}
+		}
+	}
+	return ret
+}
+
+func (idx *Index) addDerived(source, derived, kanji, similarKanji string, score float32) {
+	idx.Add(source, kanji, similarKanji, score)
+	for i, e := range idx.data[kanji] {
+		if e.Kan != similarKanji {
+			continue
+		}
+		for j, s := range e.Sources {
+			if s.Source == source {
+				idx.data[kanji][i].Sources[j].Derived = derived
+			}
+		}
+	}
+}
+
+// Close adds the edges described by the policy and returns how many were
+// added for each source, in the order the sources were added to the index.
+func (idx *Index) Close(p ClosurePolicy) ([]ClosureCounts, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	bySource := idx.sourceEdges()
+	var ret []ClosureCounts
+	for _, source := range idx.sources {
+		e := bySource[source]
+		counts := ClosureCounts{Source: source}
+
+		if p.Symmetric {
+			var added [][2]string
+			var scores []float32
+			for a, neighbours := range e {
+				for b, score := range neighbours {
+					if a == b || e.has(b, a) {
+						continue
+					}
+					if p.Reverse == ReverseScaled {
+						score *= p.ReverseFactor
+					}
+					if score <= idx.Threshold {
+						continue
+					}
+					added = append(added, [2]string{b, a})
+					scores = append(scores, score)
+				}
+			}
+			for i, pair := range added {
+				idx.addDerived(source, DerivedReverse, pair[0], pair[1], scores[i])
+				e.add(pair[0], pair[1], scores[i])
+				counts.Reverse++
+			}
+		}
+
+		if p.SecondDegree {
+			best := edges{}
+			for a, neighbours := range e {
+				for b, ab := range neighbours {
+					for c, bc := range e[b] {
+						if c == a || e.has(a, c) {
+							continue
+						}
+						score := ab * bc * p.Decay
+						if score <= idx.Threshold {
+							continue
+						}
+						if existing, ok := best[a][c]; !ok || score > existing {
+							best.add(a, c, score)
+						}
+					}
+				}
+			}
+			for a, neighbours := range best {
+				for c, score := range neighbours {
+					idx.addDerived(source, DerivedSecondDegree, a, c, score)
+					counts.SecondDegree++
+				}
+			}
+		}
+
+		ret = append(ret, counts)
+	}
+	return ret, nil
+}
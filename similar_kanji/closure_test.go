@@ -0,0 +1,174 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// edgeStrings lists every edge in the index as "kanji→similar source score
+// derived".
+func edgeStrings(idx *Index) []string {
+	var ret []string
+	for kanji, entries := range idx.data {
+		for _, e := range entries {
+			for _, s := range e.Sources {
+				score := math.Round(float64(s.Score)*1000) / 1000
+				ret = append(ret, fmt.Sprintf("%s→%s %s %v %s", kanji, e.Kan, s.Source, score, s.Derived))
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func TestClose(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		policy ClosurePolicy
+		add    func(idx *Index)
+		want   []string
+		counts []ClosureCounts
+	}{
+		{
+			name:   "nothing",
+			policy: ClosurePolicy{},
+			add:    func(idx *Index) { idx.Add("a", "未", "末", 0.9) },
+			want:   []string{"未→末 a 0.9 "},
+			counts: []ClosureCounts{{Source: "a"}},
+		},
+		{
+			name:   "symmetric",
+			policy: ClosurePolicy{Symmetric: true},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("a", "土", "士", 0.8)
+				idx.Add("a", "士", "土", 0.7)
+			},
+			want: []string{
+				"土→士 a 0.8 ", "士→土 a 0.7 ",
+				"未→末 a 0.9 ", "末→未 a 0.9 reverse",
+			},
+			counts: []ClosureCounts{{Source: "a", Reverse: 1}},
+		},
+		{
+			name:   "symmetric scaled",
+			policy: ClosurePolicy{Symmetric: true, Reverse: ReverseScaled, ReverseFactor: 0.5},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("a", "土", "士", 0.7)
+			},
+			want:   []string{"土→士 a 0.7 ", "未→末 a 0.9 ", "末→未 a 0.45 reverse"},
+			counts: []ClosureCounts{{Source: "a", Reverse: 1}},
+		},
+		{
+			name:   "each source separately",
+			policy: ClosurePolicy{Symmetric: true},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("b", "末", "未", 0.6)
+			},
+			want: []string{
+				"未→末 a 0.9 ", "未→末 b 0.6 reverse",
+				"末→未 a 0.9 reverse", "末→未 b 0.6 ",
+			},
+			counts: []ClosureCounts{{Source: "a", Reverse: 1}, {Source: "b", Reverse: 1}},
+		},
+		{
+			name:   "second degree",
+			policy: ClosurePolicy{SecondDegree: true, Decay: 1},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("a", "末", "本", 0.8)
+				idx.Add("a", "本", "未", 0.5)
+			},
+			want: []string{
+				"未→末 a 0.9 ", "未→本 a 0.72 second_degree",
+				"末→本 a 0.8 ",
+				"本→未 a 0.5 ", "本→末 a 0.45 second_degree",
+			},
+			counts: []ClosureCounts{{Source: "a", SecondDegree: 2}},
+		},
+		{
+			name:   "second degree decays below the threshold",
+			policy: ClosurePolicy{SecondDegree: true, Decay: 0.5},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("a", "末", "本", 0.8)
+			},
+			want:   []string{"未→末 a 0.9 ", "末→本 a 0.8 "},
+			counts: []ClosureCounts{{Source: "a"}},
+		},
+		{
+			name:   "second degree uses reverse edges",
+			policy: ClosurePolicy{Symmetric: true, SecondDegree: true, Decay: 1},
+			add: func(idx *Index) {
+				idx.Add("a", "未", "末", 0.9)
+				idx.Add("a", "本", "末", 0.8)
+			},
+			want: []string{
+				"未→末 a 0.9 ", "未→本 a 0.72 second_degree",
+				"末→未 a 0.9 reverse", "末→本 a 0.8 reverse",
+				"本→未 a 0.72 second_degree", "本→末 a 0.8 ",
+			},
+			counts: []ClosureCounts{{Source: "a", Reverse: 2, SecondDegree: 2}},
+		},
+		{
+			name:   "empty index",
+			policy: ClosurePolicy{Symmetric: true, SecondDegree: true, Decay: 1},
+			add:    func(idx *Index) {},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			idx := Create()
+			tc.add(idx)
+			counts, err := idx.Close(tc.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := edgeStrings(idx); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got edges %q, want %q", got, tc.want)
+			}
+			if !reflect.DeepEqual(counts, tc.counts) {
+				t.Errorf("got counts %+v, want %+v", counts, tc.counts)
+			}
+		})
+	}
+}
+
+func TestClosurePolicyValidate(t *testing.T) {
+	for _, tc := range []struct {
+		policy ClosurePolicy
+		ok     bool
+	}{
+		{ClosurePolicy{}, true},
+		{ClosurePolicy{Symmetric: true, Reverse: ReverseSame}, true},
+		{ClosurePolicy{Symmetric: true, Reverse: ReverseScaled, ReverseFactor: 1}, true},
+		{ClosurePolicy{Symmetric: true, Reverse: "flipped"}, false},
+		{ClosurePolicy{Symmetric: true, Reverse: ReverseScaled}, false},
+		{ClosurePolicy{Symmetric: true, Reverse: ReverseScaled, ReverseFactor: 1.5}, false},
+		{ClosurePolicy{SecondDegree: true, Decay: 0.5}, true},
+		{ClosurePolicy{SecondDegree: true}, false},
+		{ClosurePolicy{SecondDegree: true, Decay: 2}, false},
+	} {
+		if err := tc.policy.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%+v: got %v, want ok = %v", tc.policy, err, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command confusion finds kanji that are confused with each other in exported
+// review history, and writes them as a scored source for the similar_kanji
+// command.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	"github.com/davidsansome/tsurukame/similar_kanji/confusion"
+)
+
+var (
+	subjects   = flag.String("subjects", "", "Length-delimited Subject messages")
+	progress   = flag.String("progress", "", "JSON Lines of ReviewMistake messages")
+	statistics = flag.String("statistics", "", "Optional JSON Lines of ReviewStatistic messages")
+	minCount   = flag.Float64("min_count", 2, "Minimum number of confusions to include a pair")
+	output     = flag.String("output", "", "File to write the result to, or stdout if empty")
+)
+
+func run() error {
+	if *subjects == "" || *progress == "" {
+		return errors.New("-subjects and -progress are required")
+	}
+
+	f, err := os.Open(*subjects)
+	if err != nil {
+		return err
+	}
+	s, err := datafile.ReadSubjects(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", *subjects, err)
+	}
+
+	f, err = os.Open(*progress)
+	if err != nil {
+		return err
+	}
+	mistakes, err := confusion.ReadMistakes(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", *progress, err)
+	}
+
+	var stats []*proto.ReviewStatistic
+	if *statistics != "" {
+		f, err = os.Open(*statistics)
+		if err != nil {
+			return err
+		}
+		stats, err = confusion.ReadStatistics(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", *statistics, err)
+		}
+	}
+
+	results, scored := confusion.Score(s, mistakes, stats, confusion.Options{MinCount: *minCount})
+	fmt.Fprintf(os.Stderr, "Found %d confused pairs in %d mistakes\n", len(results), len(mistakes))
+	for _, r := range results {
+		fmt.Fprintf(os.Stderr, "  %s → %s: %.1f of %d wrong answers\n", r.Kanji, r.Similar, r.Count, r.WrongTotal)
+	}
+
+	data, err := json.MarshalIndent(scored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	return os.WriteFile(*output, append(data, '\n'), 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command diff compares two outputs of the similar_kanji command, in compact or
+// scored format, and lists the similar kanji that were added, removed,
+// reordered or rescored for each kanji.
+//
+// Usage:
+//
+//	diff [-json] old.json new.json
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/similar_kanji"
+)
+
+var (
+	jsonOutput = flag.Bool("json", false, "Write the diff as JSON instead of text")
+)
+
+func run() error {
+	if flag.NArg() != 2 {
+		return errors.New("usage: diff [-json] old.json new.json")
+	}
+
+	old, oldScored, err := similar_kanji.LoadOutput(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	updated, newScored, err := similar_kanji.LoadOutput(flag.Arg(1))
+	if err != nil {
+		return err
+	}
+	diffs := similar_kanji.Diff(old, updated, oldScored, newScored)
+
+	if *jsonOutput {
+		data, err := json.MarshalIndent(diffs, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	if len(diffs) == 0 {
+		fmt.Println("No differences")
+		return nil
+	}
+	fmt.Printf("%d kanji changed\n", len(diffs))
+	return similar_kanji.WriteDiff(os.Stdout, diffs)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
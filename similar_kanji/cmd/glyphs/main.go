@@ -0,0 +1,152 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command glyphs scores how alike kanji look when rendered with local font
+// files, and writes the scores in the format read by
+// similar_kanji.Index.AddScoredFile.
+//
+// Either every pair of the kanji in -only is compared, or just the pairs in a
+// -candidates file written by the similar_kanji command.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/davidsansome/tsurukame/similar_kanji"
+	"github.com/davidsansome/tsurukame/similar_kanji/glyphs"
+)
+
+var (
+	fonts      = flag.String("fonts", "", "Comma-separated TrueType or OpenType font files, eg. www/fonts/sawarabi-mincho.ttf")
+	only       = flag.String("only", "", "Text file of kanji to compare every pair of")
+	candidates = flag.String("candidates", "", "Output of the similar_kanji command whose pairs to compare")
+	size       = flag.Int("size", 32, "Width and height of the normalized bitmaps")
+	threshold  = flag.Float64("threshold", 0.5, "Minimum similarity to include a pair")
+	limit      = flag.Int("limit", 10, "Maximum number of similar kanji for each kanji, or 0 for no limit")
+	cacheFile  = flag.String("cache", "", "File to cache rendered bitmaps and scores in between runs")
+	output     = flag.String("output", "", "File to write the result to, or stdout if empty")
+)
+
+func loadCandidates() (map[string][]string, error) {
+	if *candidates != "" {
+		data, _, err := similar_kanji.LoadOutput(*candidates)
+		if err != nil {
+			return nil, err
+		}
+		ret := map[string][]string{}
+		for kanji, entries := range data {
+			for _, e := range entries {
+				ret[kanji] = append(ret[kanji], e.Kan)
+			}
+		}
+		return ret, nil
+	}
+
+	b, err := os.ReadFile(*only)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var chars []string
+	for _, r := range string(b) {
+		if !unicode.IsSpace(r) && !seen[string(r)] {
+			seen[string(r)] = true
+			chars = append(chars, string(r))
+		}
+	}
+	return glyphs.AllPairs(chars), nil
+}
+
+func run() error {
+	if *fonts == "" {
+		return errors.New("-fonts is required")
+	}
+	if (*only == "") == (*candidates == "") {
+		return errors.New("exactly one of -only and -candidates is required")
+	}
+	if *size <= 0 {
+		return errors.New("-size must be positive")
+	}
+
+	var loaded []*glyphs.Font
+	for _, name := range strings.Split(*fonts, ",") {
+		f, err := glyphs.LoadFont(name)
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, f)
+	}
+
+	c, err := loadCandidates()
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	var chars []string
+	for kanji, others := range c {
+		for _, char := range append([]string{kanji}, others...) {
+			if !seen[char] {
+				seen[char] = true
+				chars = append(chars, char)
+			}
+		}
+	}
+	sort.Strings(chars)
+
+	cache, err := glyphs.LoadCache(*cacheFile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *cacheFile, err)
+	}
+	s := glyphs.NewScorer(loaded, *size, cache)
+	if err := s.Render(chars); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Rendered %d kanji in %d font(s)\n", len(chars), len(loaded))
+
+	scored, err := s.ScoreAll(c, glyphs.Options{
+		Threshold: float32(*threshold),
+		Limit:     *limit,
+	})
+	if err != nil {
+		return err
+	}
+	if err := cache.Save(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(scored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	return os.WriteFile(*output, append(data, '\n'), 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command homophones reads a stream of length-delimited Subject messages,
+// fills in the same-reading kanji and phonetic family of every kanji subject,
+// and writes the updated subjects in the same format.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/similar_kanji/homophones"
+)
+
+var (
+	subjects = flag.String("subjects", "", "Length-delimited Subject messages")
+	output   = flag.String("output", "", "File to write the updated subjects to")
+	groups   = flag.String("groups", "", "File to write a JSON list of the groups and families to")
+	minSize  = flag.Int("min_size", 2, "Minimum number of kanji in a phonetic family")
+	minShare = flag.Float64("min_share", 0.5, "Minimum fraction of the kanji containing a component that must share its reading")
+)
+
+func run() error {
+	if *subjects == "" || *output == "" {
+		return errors.New("-subjects and -output are required")
+	}
+
+	in, err := os.Open(*subjects)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	s, err := datafile.ReadSubjects(in)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *subjects, err)
+	}
+
+	g := homophones.SameReading(s)
+	f := homophones.Families(s, homophones.Options{
+		MinSize:  *minSize,
+		MinShare: *minShare,
+	})
+	r := homophones.ApplyToSubjects(s, g, f)
+
+	out, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := datafile.WriteSubjects(out, s); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d readings shared by %d kanji, %d phonetic families covering %d kanji\n",
+		len(g), r.SameReading, len(f), r.Families)
+
+	if *groups == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(struct {
+		SameReading []homophones.Group  `json:"same_reading"`
+		Families    []homophones.Family `json:"phonetic_families"`
+	}{g, f}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*groups, append(data, '\n'), 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
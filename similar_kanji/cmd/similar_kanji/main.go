@@ -0,0 +1,399 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command similar_kanji merges similar kanji datasets into the compact JSON
+// format used by the app.
+//
+// Sources are read from a manifest file (see similar_kanji/sources.json), from
+// the -scored and -unscored flags, or both.
+//
+// With -subjects, it instead reads a stream of length-delimited Subject
+// messages, fills in the visually similar kanji of every kanji subject and
+// writes the updated subjects in the same format.  -vocabulary also writes the
+// visually similar vocabulary of each vocabulary subject as JSON.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/datafile"
+	"github.com/davidsansome/tsurukame/proto"
+	"github.com/davidsansome/tsurukame/similar_kanji"
+)
+
+type fileList []string
+
+func (l *fileList) String() string { return strings.Join(*l, ",") }
+
+func (l *fileList) Set(value string) error {
+	*l = append(*l, strings.Split(value, ",")...)
+	return nil
+}
+
+type thresholdMap map[string]float32
+
+func (m thresholdMap) String() string {
+	var parts []string
+	for name, t := range m {
+		parts = append(parts, fmt.Sprintf("%s=%v", name, t))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (m thresholdMap) Set(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		name, t, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("expected name=value, got %q", part)
+		}
+		f, err := strconv.ParseFloat(t, 32)
+		if err != nil {
+			return err
+		}
+		m[name] = float32(f)
+	}
+	return nil
+}
+
+var (
+	manifestFile = flag.String("manifest", "", "JSON manifest listing the sources to merge")
+	threshold    = flag.Float64("threshold", similar_kanji.DefaultThreshold, "Minimum score for entries from scored sources that don't set their own")
+	strict       = flag.Bool("strict", false, "Fail if any source has invalid kanji or entries, instead of skipping them")
+	problemsFile = flag.String("problems", "", "File to write a JSON list of the problems found in the sources to")
+	overrides    = flag.String("overrides", "", "JSON file of pairs to deny or pin after merging the sources")
+	limit        = flag.Int("limit", 0, "Maximum number of similar kanji for each kanji, or 0 for no limit")
+	output       = flag.String("output", "", "File to write the result to, or stdout if empty")
+	fusion       = flag.String("fusion", string(similar_kanji.FusionMax), "How to combine scores from several sources: max, weighted_mean or noisy_or")
+	format       = flag.String("format", "compact", "Output format: compact, or scored to include scores and their sources")
+	symmetric    = flag.Bool("symmetric", false, "Add B→A for every A→B")
+	reverse      = flag.String("reverse", string(similar_kanji.ReverseSame), "Score of edges added by -symmetric: same, or scaled by -reverse_factor")
+	reverseFac   = flag.Float64("reverse_factor", 1.0, "Factor for -reverse=scaled")
+	secondDegree = flag.Bool("second_degree", false, "Add A→C for every A→B→C")
+	decay        = flag.Float64("decay", 0.5, "Factor for the scores of edges added by -second_degree")
+	subjects     = flag.String("subjects", "", "Length-delimited Subject messages to add the similar kanji to")
+	report       = flag.String("report", "", "File to write a JSON report of similar kanji with no WaniKani subject to")
+	vocabulary   = flag.String("vocabulary", "", "File to write the similar vocabulary of each vocabulary subject to, with -subjects")
+	vocabReading = flag.Bool("vocabulary_shared_reading", false, "Only include similar vocabulary that shares a reading")
+	vocabLimit   = flag.Int("vocabulary_limit", 0, "Maximum number of similar words for each word, or 0 for no limit")
+
+	scoredFiles      fileList
+	unscoredFiles    fileList
+	sourceThresholds = thresholdMap{}
+)
+
+func init() {
+	flag.Var(&scoredFiles, "scored", "Scored JSON file to merge (can be repeated)")
+	flag.Var(&unscoredFiles, "unscored", "Unscored JSON file to merge (can be repeated)")
+	flag.Var(sourceThresholds, "source_threshold", "Minimum score for one source, as name=value (can be repeated)")
+}
+
+func isFlagSet(name string) bool {
+	ret := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			ret = true
+		}
+	})
+	return ret
+}
+
+func run() error {
+	m := &similar_kanji.Manifest{}
+	if *manifestFile != "" {
+		var err error
+		if m, err = similar_kanji.LoadManifest(*manifestFile); err != nil {
+			return err
+		}
+	}
+	for _, f := range unscoredFiles {
+		m.Sources = append(m.Sources, similar_kanji.Source{File: f})
+	}
+	for _, f := range scoredFiles {
+		m.Sources = append(m.Sources, similar_kanji.Source{File: f, Scored: true})
+	}
+	if len(m.Sources) == 0 {
+		return errors.New("no sources given: use -manifest, -scored or -unscored")
+	}
+
+	idx := similar_kanji.Create()
+	if m.Threshold != nil {
+		idx.Threshold = *m.Threshold
+	}
+	if isFlagSet("threshold") {
+		idx.Threshold = float32(*threshold)
+	}
+	if m.Fusion != "" {
+		idx.Fusion = m.Fusion
+	}
+	if isFlagSet("fusion") {
+		f, err := similar_kanji.ParseFusion(*fusion)
+		if err != nil {
+			return err
+		}
+		idx.Fusion = f
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "symmetric":
+			m.Closure.Symmetric = *symmetric
+		case "reverse":
+			m.Closure.Reverse = similar_kanji.ReverseScore(*reverse)
+		case "reverse_factor":
+			m.Closure.ReverseFactor = float32(*reverseFac)
+		case "second_degree":
+			m.Closure.SecondDegree = *secondDegree
+		case "decay":
+			m.Closure.Decay = float32(*decay)
+		}
+	})
+	if m.Closure.Reverse == similar_kanji.ReverseScaled && m.Closure.ReverseFactor == 0 {
+		m.Closure.ReverseFactor = float32(*reverseFac)
+	}
+	if m.Closure.SecondDegree && m.Closure.Decay == 0 {
+		m.Closure.Decay = float32(*decay)
+	}
+	if err := m.Closure.Validate(); err != nil {
+		return err
+	}
+
+	for name, t := range sourceThresholds {
+		found := false
+		for i := range m.Sources {
+			if m.Sources[i].SourceName() == name {
+				t := t
+				m.Sources[i].Threshold = &t
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("-source_threshold: no source named %q", name)
+		}
+	}
+	if isFlagSet("limit") {
+		m.Limit = *limit
+	}
+	if *overrides != "" {
+		m.Overrides = *overrides
+	}
+	var o *similar_kanji.Overrides
+	if m.Overrides != "" {
+		var err error
+		if o, err = similar_kanji.LoadOverrides(m.Overrides); err != nil {
+			return err
+		}
+		if m.Limit > 0 {
+			if err := o.CheckLimit(m.Limit); err != nil {
+				return fmt.Errorf("%s: %w", m.Overrides, err)
+			}
+		}
+	}
+	if isFlagSet("strict") {
+		m.Strict = *strict
+	}
+	idx.Strict = m.Strict
+
+	var failed []string
+	for _, src := range m.Sources {
+		err := idx.AddSource(src)
+		switch {
+		case err == nil:
+			fmt.Fprintf(os.Stderr, "Loaded %s\n", src.File)
+		case errors.Is(err, fs.ErrNotExist) && src.Optional:
+			fmt.Fprintf(os.Stderr, "Skipping optional source %s: file not found\n", src.File)
+		default:
+			fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", src.File, err)
+			failed = append(failed, src.File)
+		}
+	}
+	for _, w := range idx.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", w)
+	}
+	for _, p := range idx.Problems {
+		fmt.Fprintf(os.Stderr, "Skipped %v\n", p)
+	}
+	if *problemsFile != "" {
+		if err := writeProblems(idx.Problems, *problemsFile); err != nil {
+			return err
+		}
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("%d source(s) could not be loaded: %s", len(failed), strings.Join(failed, ", "))
+	}
+
+	if m.Closure.Symmetric || m.Closure.SecondDegree {
+		counts, err := idx.Close(m.Closure)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(os.Stderr, "Edges added by closure:")
+		for _, c := range counts {
+			fmt.Fprintf(os.Stderr, "  %-24s %6d reverse %6d second degree\n", c.Source, c.Reverse, c.SecondDegree)
+		}
+	}
+	idx.Sort()
+
+	if o != nil {
+		stale := 0
+		for _, r := range idx.ApplyOverrides(o) {
+			status := "used"
+			if !r.Used {
+				status = "stale"
+				stale++
+			}
+			fmt.Fprintf(os.Stderr, "Override %s %s: %s\n", r.Kind, &r.Override, status)
+		}
+		if stale != 0 {
+			fmt.Fprintf(os.Stderr, "%d override(s) are stale: their pairs aren't in the index\n", stale)
+		}
+	}
+
+	var s []*proto.Subject
+	var unresolved []similar_kanji.Unresolved
+	if *subjects != "" {
+		var err error
+		if s, err = readSubjects(); err != nil {
+			return err
+		}
+		// Do this before the limit, so each kanji keeps as many similar kanji as
+		// the app can show.
+		unresolved = idx.RemoveUnresolved(s)
+	}
+
+	if m.Limit > 0 {
+		before := idx.Histogram()
+		removed := idx.Limit(m.Limit)
+		fmt.Fprintf(os.Stderr, "Removed %d entries over the limit of %d\n", removed, m.Limit)
+		similar_kanji.WriteHistograms(os.Stderr, []string{"before", "after"}, before, idx.Histogram())
+	} else {
+		similar_kanji.WriteHistograms(os.Stderr, []string{"kanji"}, idx.Histogram())
+	}
+
+	path := m.Output
+	if *output != "" {
+		path = *output
+	}
+	if *subjects != "" {
+		return writeSubjects(idx, s, unresolved, path)
+	}
+
+	var data []byte
+	var err error
+	switch *format {
+	case "compact":
+		data, err = json.Marshal(idx.Compact())
+	case "scored":
+		data, err = json.MarshalIndent(idx.Scored(), "", "  ")
+	default:
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if path == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func writeProblems(problems []similar_kanji.Problem, path string) error {
+	if problems == nil {
+		problems = []similar_kanji.Problem{}
+	}
+	data, err := json.MarshalIndent(problems, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func readSubjects() ([]*proto.Subject, error) {
+	in, err := os.Open(*subjects)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+	s, err := datafile.ReadSubjects(in)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", *subjects, err)
+	}
+	return s, nil
+}
+
+func writeSubjects(idx *similar_kanji.Index, s []*proto.Subject, unresolved []similar_kanji.Unresolved, path string) error {
+	if path == "" {
+		return errors.New("-output is required with -subjects")
+	}
+
+	if *vocabulary != "" {
+		v := idx.SimilarVocabulary(s, similar_kanji.VocabularyOptions{
+			SharedReading: *vocabReading,
+			Limit:         *vocabLimit,
+		})
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*vocabulary, append(data, '\n'), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Found similar vocabulary for %d words\n", len(v))
+	}
+
+	r := idx.ApplyToSubjects(s)
+	r.Unresolved = append(unresolved, r.Unresolved...)
+
+	out, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := datafile.WriteSubjects(out, s); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Updated %d kanji subjects, %d not in the index\n", r.Updated, len(r.NotInIndex))
+	fmt.Fprintf(os.Stderr, "%d similar kanji have no WaniKani subject\n", len(r.Unresolved))
+	if *report == "" {
+		for _, u := range r.Unresolved {
+			fmt.Fprintf(os.Stderr, "  %s (%d): %s\n", u.Kanji, u.SubjectID, u.SimilarKanji)
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(*report, append(data, '\n'), 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command strokes computes stroke edit distances between kanji from a local
+// directory of KanjiVG SVG files, and writes them in the scored format of
+// stroke_edit_dist.json.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davidsansome/tsurukame/similar_kanji"
+	"github.com/davidsansome/tsurukame/similar_kanji/strokes"
+)
+
+var (
+	kanjivg   = flag.String("kanjivg", "", "Directory containing KanjiVG SVG files, named like 04e00.svg")
+	only      = flag.String("only", "", "Text file containing the kanji to find similar kanji for.  Defaults to every kanji in -kanjivg")
+	threshold = flag.Float64("threshold", 0.5, "Minimum similarity to include a pair")
+	limit     = flag.Int("limit", 10, "Maximum number of similar kanji for each kanji, or 0 for no limit")
+	output    = flag.String("output", "", "File to write the result to, or stdout if empty")
+)
+
+func run() error {
+	if *kanjivg == "" {
+		return errors.New("-kanjivg is required")
+	}
+
+	opts := strokes.Options{
+		Threshold: *threshold,
+		Limit:     *limit,
+	}
+	if *only != "" {
+		var err error
+		if opts.Only, err = similar_kanji.ReadKanjiList(*only); err != nil {
+			return err
+		}
+	}
+
+	kanji, errs := strokes.LoadDir(*kanjivg)
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "Skipping %v\n", err)
+	}
+	if len(kanji) == 0 {
+		return fmt.Errorf("no KanjiVG files found in %s", *kanjivg)
+	}
+	fmt.Fprintf(os.Stderr, "Loaded %d kanji\n", len(kanji))
+
+	data, err := json.MarshalIndent(strokes.ScoreAll(kanji, opts), "", "  ")
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	return os.WriteFile(*output, append(data, '\n'), 0644)
+}
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
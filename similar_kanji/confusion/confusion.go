@@ -0,0 +1,244 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package confusion finds kanji that learners confuse with each other, from
+// their review history.  A wrong answer for one kanji that is an accepted
+// answer for another counts as one confusion between the two.
+package confusion
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/davidsansome/tsurukame/proto"
+	"github.com/davidsansome/tsurukame/similar_kanji"
+	"github.com/davidsansome/tsurukame/utils"
+	"google.golang.org/protobuf/encoding/protojson"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// readJSONLines reads JSON Lines of messages in the protobuf JSON format.
+func readJSONLines[T gproto.Message](r io.Reader, newMessage func() T) ([]T, error) {
+	var ret []T
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		m := newMessage()
+		if err := protojson.Unmarshal(scanner.Bytes(), m); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		ret = append(ret, m)
+	}
+	return ret, scanner.Err()
+}
+
+// ReadMistakes reads JSON Lines of ReviewMistake messages.  Mistakes without
+// an answer still count towards how often the kanji was answered wrongly.
+func ReadMistakes(r io.Reader) ([]*proto.ReviewMistake, error) {
+	return readJSONLines(r, func() *proto.ReviewMistake { return &proto.ReviewMistake{} })
+}
+
+// ReadStatistics reads JSON Lines of ReviewStatistic messages.
+func ReadStatistics(r io.Reader) ([]*proto.ReviewStatistic, error) {
+	return readJSONLines(r, func() *proto.ReviewStatistic { return &proto.ReviewStatistic{} })
+}
+
+// normalizeMeaning makes meaning answers comparable the same way the app does:
+// case, surrounding whitespace and repeated spaces don't matter.
+func normalizeMeaning(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// normalizeReading makes reading answers comparable with the stored readings,
+// which are in hiragana.
+func normalizeReading(s string) string {
+	return utils.ToHiragana(strings.TrimSpace(s))
+}
+
+// answerField returns which part of the review the mistake's answer was for,
+// or UNKNOWN if that part wasn't wrong.
+func answerField(m *proto.ReviewMistake) proto.ReviewMistake_Field {
+	p := m.GetProgress()
+	meaning, reading := p.GetMeaningWrong(), p.GetReadingWrong()
+	field := m.GetField()
+	if field == proto.ReviewMistake_UNKNOWN {
+		switch {
+		case meaning && reading && isKana(normalizeReading(m.GetAnswer())):
+			field = proto.ReviewMistake_READING
+		case meaning:
+			field = proto.ReviewMistake_MEANING
+		case reading:
+			field = proto.ReviewMistake_READING
+		}
+	}
+	if field == proto.ReviewMistake_MEANING && !meaning || field == proto.ReviewMistake_READING && !reading {
+		return proto.ReviewMistake_UNKNOWN
+	}
+	return field
+}
+
+func isKana(s string) bool {
+	for _, r := range s {
+		if !unicode.Is(unicode.Hiragana, r) && r != 'ー' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Options controls which pairs Score returns.
+type Options struct {
+	// MinCount is the number of times a pair has to be confused to be kept.
+	MinCount float64
+}
+
+// Result is a confused pair and the evidence for it.
+type Result struct {
+	Kanji      string
+	Similar    string
+	Count      float64
+	WrongTotal int
+}
+
+// Score finds the kanji pairs confused in the mistakes.  Each pair is scored
+// by the fraction of the kanji's wrong answers that were answers for the
+// other kanji.  The number of wrong answers comes from the review statistics
+// if there are any for the kanji, or from the mistakes otherwise.
+func Score(subjects []*proto.Subject, mistakes []*proto.ReviewMistake, stats []*proto.ReviewStatistic, opts Options) ([]Result, map[string]similar_kanji.EntryList) {
+	kanji := map[int64]*proto.Subject{}
+	byMeaning := map[string][]int64{}
+	byReading := map[string][]int64{}
+	for _, s := range subjects {
+		if s.Kanji == nil {
+			continue
+		}
+		kanji[s.GetId()] = s
+		for _, m := range s.Meanings {
+			if m.GetType() == proto.Meaning_PRIMARY || m.GetType() == proto.Meaning_SECONDARY {
+				key := normalizeMeaning(m.GetMeaning())
+				byMeaning[key] = append(byMeaning[key], s.GetId())
+			}
+		}
+		// Only primary readings, since an answer that matches another kanji's
+		// secondary reading is more likely a vocabulary reading than a confusion.
+		for _, r := range s.Readings {
+			if r.GetIsPrimary() {
+				key := normalizeReading(r.GetReading())
+				byReading[key] = append(byReading[key], s.GetId())
+			}
+		}
+	}
+
+	wrong := map[int64]int{}
+	for _, s := range stats {
+		if _, ok := kanji[s.GetSubjectId()]; ok && !s.GetHidden() {
+			wrong[s.GetSubjectId()] += int(s.GetMeaningIncorrect() + s.GetReadingIncorrect())
+		}
+	}
+	hasStats := map[int64]bool{}
+	for id := range wrong {
+		hasStats[id] = true
+	}
+
+	counts := map[[2]int64]float64{}
+	for _, m := range mistakes {
+		p := m.GetProgress()
+		id := p.GetAssignment().GetSubjectId()
+		if _, ok := kanji[id]; !ok {
+			continue
+		}
+		if !hasStats[id] {
+			wrong[id] += int(max32(p.GetMeaningWrongCount(), b2i(p.GetMeaningWrong())) +
+				max32(p.GetReadingWrongCount(), b2i(p.GetReadingWrong())))
+		}
+		answer := m.GetAnswer()
+		if answer == "" {
+			continue
+		}
+
+		var matches []int64
+		switch answerField(m) {
+		case proto.ReviewMistake_MEANING:
+			matches = byMeaning[normalizeMeaning(answer)]
+		case proto.ReviewMistake_READING:
+			matches = byReading[normalizeReading(answer)]
+		}
+		var others []int64
+		seen := map[int64]bool{id: true}
+		for _, other := range matches {
+			if !seen[other] {
+				seen[other] = true
+				others = append(others, other)
+			}
+		}
+		// An answer shared by several other kanji is split between them.
+		for _, other := range others {
+			counts[[2]int64{id, other}] += 1 / float64(len(others))
+		}
+	}
+
+	var results []Result
+	scored := map[string]similar_kanji.EntryList{}
+	for pair, count := range counts {
+		total := wrong[pair[0]]
+		if count < opts.MinCount || total == 0 {
+			continue
+		}
+		r := Result{kanji[pair[0]].GetJapanese(), kanji[pair[1]].GetJapanese(), count, total}
+		results = append(results, r)
+
+		score := count / float64(total)
+		if score > 1 {
+			score = 1
+		}
+		scored[r.Kanji] = append(scored[r.Kanji], similar_kanji.Entry{Kan: r.Similar, Score: float32(score)})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Count != results[j].Count {
+			return results[i].Count > results[j].Count
+		}
+		if results[i].Kanji != results[j].Kanji {
+			return results[i].Kanji < results[j].Kanji
+		}
+		return results[i].Similar < results[j].Similar
+	})
+	for _, entries := range scored {
+		sort.Sort(entries)
+	}
+	return results, scored
+}
+
+func b2i(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
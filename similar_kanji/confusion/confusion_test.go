@@ -0,0 +1,179 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confusion
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func kanji(id int64, japanese, meaning string, readings ...string) *proto.Subject {
+	s := &proto.Subject{
+		Id:       gproto.Int64(id),
+		Japanese: gproto.String(japanese),
+		Meanings: []*proto.Meaning{{Meaning: gproto.String(meaning), Type: proto.Meaning_PRIMARY.Enum()}},
+		Kanji:    &proto.Kanji{},
+	}
+	for i, r := range readings {
+		s.Readings = append(s.Readings, &proto.Reading{Reading: gproto.String(r), IsPrimary: gproto.Bool(i == 0)})
+	}
+	return s
+}
+
+func testSubjects() []*proto.Subject {
+	return []*proto.Subject{
+		kanji(1, "未", "Not Yet", "み", "ひつじ"),
+		kanji(2, "末", "End", "まつ", "すえ"),
+		kanji(3, "本", "Book", "ほん"),
+		kanji(4, "体", "Body", "たい"),
+		kanji(5, "待", "Wait", "たい"),
+	}
+}
+
+// wrongMeaning and wrongReading are mistakes for 未.
+func wrongMeaning(answer string) *proto.ReviewMistake {
+	return &proto.ReviewMistake{
+		Progress: &proto.Progress{Assignment: &proto.Assignment{SubjectId: gproto.Int64(1)}, MeaningWrong: gproto.Bool(true)},
+		Answer:   gproto.String(answer),
+	}
+}
+
+func wrongReading(answer string) *proto.ReviewMistake {
+	return &proto.ReviewMistake{
+		Progress: &proto.Progress{Assignment: &proto.Assignment{SubjectId: gproto.Int64(1)}, ReadingWrong: gproto.Bool(true)},
+		Answer:   gproto.String(answer),
+	}
+}
+
+func wrongBoth(answer string, field proto.ReviewMistake_Field) *proto.ReviewMistake {
+	m := wrongMeaning(answer)
+	m.Progress.ReadingWrong = gproto.Bool(true)
+	if field != proto.ReviewMistake_UNKNOWN {
+		m.Field = field.Enum()
+	}
+	return m
+}
+
+func TestScore(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		mistakes []*proto.ReviewMistake
+		stats    []*proto.ReviewStatistic
+		minCount float64
+		want     []Result
+	}{
+		{
+			name:     "meaning",
+			mistakes: []*proto.ReviewMistake{wrongMeaning("end"), wrongMeaning("  END ")},
+			want:     []Result{{"未", "末", 2, 2}},
+		},
+		{
+			name:     "reading in katakana",
+			mistakes: []*proto.ReviewMistake{wrongReading("マツ")},
+			want:     []Result{{"未", "末", 1, 1}},
+		},
+		{
+			name:     "secondary readings don't match",
+			mistakes: []*proto.ReviewMistake{wrongReading("すえ")},
+		},
+		{
+			name:     "own answer isn't a confusion",
+			mistakes: []*proto.ReviewMistake{wrongReading("み"), wrongMeaning("not yet")},
+		},
+		{
+			name:     "answer is only compared with the wrong field",
+			mistakes: []*proto.ReviewMistake{wrongReading("end"), wrongMeaning("まつ")},
+		},
+		{
+			name:     "kana answer with both wrong is a reading",
+			mistakes: []*proto.ReviewMistake{wrongBoth("ほん", proto.ReviewMistake_UNKNOWN), wrongBoth("end", proto.ReviewMistake_UNKNOWN)},
+			want:     []Result{{"未", "末", 1, 4}, {"未", "本", 1, 4}},
+		},
+		{
+			name:     "field says which answer it was",
+			mistakes: []*proto.ReviewMistake{wrongBoth("ほん", proto.ReviewMistake_MEANING), wrongBoth("ほん", proto.ReviewMistake_READING)},
+			want:     []Result{{"未", "本", 1, 4}},
+		},
+		{
+			name: "field that wasn't wrong",
+			mistakes: []*proto.ReviewMistake{func() *proto.ReviewMistake {
+				m := wrongMeaning("ほん")
+				m.Field = proto.ReviewMistake_READING.Enum()
+				return m
+			}()},
+		},
+		{
+			name:     "shared answer is split",
+			mistakes: []*proto.ReviewMistake{wrongReading("たい"), wrongReading("たい"), wrongReading("たい")},
+			want:     []Result{{"未", "体", 1.5, 3}, {"未", "待", 1.5, 3}},
+		},
+		{
+			name: "wrong counts from progress",
+			mistakes: []*proto.ReviewMistake{
+				func() *proto.ReviewMistake {
+					m := wrongMeaning("end")
+					m.Progress.MeaningWrongCount = gproto.Int32(3)
+					return m
+				}(),
+				{Progress: &proto.Progress{Assignment: &proto.Assignment{SubjectId: gproto.Int64(1)}, ReadingWrong: gproto.Bool(true)}},
+			},
+			want: []Result{{"未", "末", 1, 4}},
+		},
+		{
+			name:     "wrong counts from statistics",
+			mistakes: []*proto.ReviewMistake{wrongMeaning("end"), wrongMeaning("end")},
+			stats: []*proto.ReviewStatistic{
+				{SubjectId: gproto.Int64(1), MeaningIncorrect: gproto.Int32(6), ReadingIncorrect: gproto.Int32(2)},
+			},
+			want: []Result{{"未", "末", 2, 8}},
+		},
+		{
+			name:     "hidden statistics are ignored",
+			mistakes: []*proto.ReviewMistake{wrongMeaning("end")},
+			stats: []*proto.ReviewStatistic{
+				{SubjectId: gproto.Int64(1), MeaningIncorrect: gproto.Int32(6), Hidden: gproto.Bool(true)},
+			},
+			want: []Result{{"未", "末", 1, 1}},
+		},
+		{
+			name:     "below MinCount",
+			mistakes: []*proto.ReviewMistake{wrongMeaning("end"), wrongReading("たい")},
+			minCount: 1,
+			want:     []Result{{"未", "末", 1, 2}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, _ := Score(testSubjects(), tc.mistakes, tc.stats, Options{MinCount: tc.minCount})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestScoreEntries(t *testing.T) {
+	mistakes := []*proto.ReviewMistake{wrongMeaning("end"), wrongMeaning("book"), wrongMeaning("book"), wrongMeaning("")}
+	_, scored := Score(testSubjects(), mistakes, nil, Options{})
+	entries := scored["未"]
+	if len(scored) != 1 || len(entries) != 2 {
+		t.Fatalf("got %v, want two entries for 未", scored)
+	}
+	if entries[0].Kan != "本" || entries[0].Score != 0.5 || entries[1].Kan != "末" || entries[1].Score != 0.25 {
+		t.Errorf("got %+v, want 本 0.5 and 末 0.25", entries)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// LoadOutput reads a file written by the similar_kanji command in either the
+// compact or the scored format.  Entries from compact files have no scores,
+// and scored reports which format it was.  An empty output has nothing to
+// tell the formats apart, and is read as scored.
+func LoadOutput(filename string) (data map[string]EntryList, scored bool, err error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, false, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, false, fmt.Errorf("%s: neither compact nor scored format: %w", filename, err)
+	}
+	scored = true
+	for _, v := range raw {
+		scored = len(v) == 0 || v[0] != '"'
+		break
+	}
+
+	data = map[string]EntryList{}
+	if scored {
+		if err := json.Unmarshal(b, &data); err != nil {
+			return nil, false, fmt.Errorf("%s: scored format: %w", filename, err)
+		}
+		return data, true, nil
+	}
+
+	compact := map[string]string{}
+	if err := json.Unmarshal(b, &compact); err != nil {
+		return nil, false, fmt.Errorf("%s: compact format: %w", filename, err)
+	}
+	for kanji, similar := range compact {
+		for _, r := range similar {
+			data[kanji] = append(data[kanji], Entry{Kan: string(r)})
+		}
+	}
+	return data, false, nil
+}
+
+// Change is one similar kanji that differs between two outputs.  Positions
+// start at 1, and are 0 if the similar kanji isn't in that output.
+type Change struct {
+	Kan      string   `json:"kan"`
+	OldPos   int      `json:"old_pos,omitempty"`
+	NewPos   int      `json:"new_pos,omitempty"`
+	OldScore *float32 `json:"old_score,omitempty"`
+	NewScore *float32 `json:"new_score,omitempty"`
+}
+
+// Delta is the difference in score, or 0 if either side has no score.
+func (c *Change) Delta() float32 {
+	if c.OldScore == nil || c.NewScore == nil {
+		return 0
+	}
+	return *c.NewScore - *c.OldScore
+}
+
+// KanjiDiff is everything that changed for one kanji.
+type KanjiDiff struct {
+	Kanji string `json:"kanji"`
+
+	Added   []Change `json:"added,omitempty"`
+	Removed []Change `json:"removed,omitempty"`
+
+	// Moved are in both outputs but in a different order relative to the other
+	// kanji in both.  Kanji that only shifted because others were added or
+	// removed before them aren't moved.
+	Moved []Change `json:"moved,omitempty"`
+
+	// Rescored kept their relative order but have a different score.
+	Rescored []Change `json:"rescored,omitempty"`
+}
+
+// inOrder returns the kanji that are in both lists and keep their order
+// relative to each other: the longest common subsequence of the two lists.
+func inOrder(old, updated EntryList) map[string]bool {
+	// lcs[i][j] is the length of the longest common subsequence of old[i:] and
+	// updated[j:].
+	lcs := make([][]int, len(old)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(updated)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(updated) - 1; j >= 0; j-- {
+			switch {
+			case old[i].Kan == updated[j].Kan:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ret := map[string]bool{}
+	for i, j := 0, 0; i < len(old) && j < len(updated); {
+		switch {
+		case old[i].Kan == updated[j].Kan:
+			ret[old[i].Kan] = true
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return ret
+}
+
+// Diff compares two outputs, and returns the kanji that differ in order.  The
+// result is empty, not nil, if nothing changed.
+func Diff(old, updated map[string]EntryList, oldScored, newScored bool) []KanjiDiff {
+	kanjiSet := map[string]bool{}
+	for k := range old {
+		kanjiSet[k] = true
+	}
+	for k := range updated {
+		kanjiSet[k] = true
+	}
+	var kanji []string
+	for k := range kanjiSet {
+		kanji = append(kanji, k)
+	}
+	sort.Strings(kanji)
+
+	ret := []KanjiDiff{}
+	for _, k := range kanji {
+		d := KanjiDiff{Kanji: k}
+		oldPos := map[string]int{}
+		for i, e := range old[k] {
+			oldPos[e.Kan] = i
+		}
+		newPos := map[string]int{}
+		for i, e := range updated[k] {
+			newPos[e.Kan] = i
+		}
+		stayed := inOrder(old[k], updated[k])
+
+		for i, e := range old[k] {
+			if _, ok := newPos[e.Kan]; !ok {
+				c := Change{Kan: e.Kan, OldPos: i + 1}
+				if oldScored {
+					c.OldScore = &old[k][i].Score
+				}
+				d.Removed = append(d.Removed, c)
+			}
+		}
+		for i, e := range updated[k] {
+			c := Change{Kan: e.Kan, NewPos: i + 1}
+			if newScored {
+				c.NewScore = &updated[k][i].Score
+			}
+			j, ok := oldPos[e.Kan]
+			if !ok {
+				d.Added = append(d.Added, c)
+				continue
+			}
+			c.OldPos = j + 1
+			if oldScored {
+				c.OldScore = &old[k][j].Score
+			}
+			if !stayed[e.Kan] {
+				d.Moved = append(d.Moved, c)
+			} else if c.Delta() != 0 {
+				d.Rescored = append(d.Rescored, c)
+			}
+		}
+
+		if len(d.Added)+len(d.Removed)+len(d.Moved)+len(d.Rescored) != 0 {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+func formatScore(s *float32) string {
+	if s == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.3f", *s)
+}
+
+// WriteDiff prints a human-readable version of a diff.
+func WriteDiff(w io.Writer, diffs []KanjiDiff) error {
+	for _, d := range diffs {
+		fmt.Fprintf(w, "%s: +%d -%d ~%d\n", d.Kanji, len(d.Added), len(d.Removed), len(d.Moved)+len(d.Rescored))
+		for _, c := range d.Removed {
+			fmt.Fprintf(w, "  - %s  #%d  %s\n", c.Kan, c.OldPos, formatScore(c.OldScore))
+		}
+		for _, c := range d.Added {
+			fmt.Fprintf(w, "  + %s  #%d  %s\n", c.Kan, c.NewPos, formatScore(c.NewScore))
+		}
+		for _, list := range [][]Change{d.Moved, d.Rescored} {
+			for _, c := range list {
+				fmt.Fprintf(w, "  ~ %s  #%d → #%d  %s → %s", c.Kan, c.OldPos, c.NewPos, formatScore(c.OldScore), formatScore(c.NewScore))
+				if c.OldScore != nil && c.NewScore != nil {
+					fmt.Fprintf(w, " (%+.3f)", c.Delta())
+				}
+				if _, err := fmt.Fprintln(w); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func entries(kanji string) EntryList {
+	var ret EntryList
+	for _, r := range kanji {
+		ret = append(ret, Entry{Kan: string(r)})
+	}
+	return ret
+}
+
+func TestInOrder(t *testing.T) {
+	for _, tc := range []struct {
+		old, updated string
+		want         string
+	}{
+		{"", "", ""},
+		{"未末本", "", ""},
+		{"", "未末本", ""},
+		{"未末本", "未末本", "未末本"},
+		{"未末本", "本未末", "未末"},
+		{"未末本", "末未本", "末本"},
+		{"未末本", "未本", "未本"},
+		{"未本", "未末本", "未本"},
+		{"未末本体", "体本末未", "体"},
+	} {
+		got := inOrder(entries(tc.old), entries(tc.updated))
+		var gotKanji []string
+		for k := range got {
+			gotKanji = append(gotKanji, k)
+		}
+		sort.Strings(gotKanji)
+		var want []string
+		for _, e := range entries(tc.want) {
+			want = append(want, e.Kan)
+		}
+		sort.Strings(want)
+		if !reflect.DeepEqual(gotKanji, want) {
+			t.Errorf("inOrder(%s, %s) = %v, want %v", tc.old, tc.updated, gotKanji, want)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	scored := func(kanji string, scores ...float32) EntryList {
+		ret := entries(kanji)
+		for i := range ret {
+			ret[i].Score = scores[i]
+		}
+		return ret
+	}
+
+	for _, tc := range []struct {
+		name                 string
+		old, updated         map[string]EntryList
+		oldScored, newScored bool
+		want                 string
+	}{
+		{
+			name:    "no changes",
+			old:     map[string]EntryList{"未": entries("末本")},
+			updated: map[string]EntryList{"未": entries("末本")},
+			want:    `[]`,
+		},
+		{
+			name:    "added and removed",
+			old:     map[string]EntryList{"未": entries("末本")},
+			updated: map[string]EntryList{"未": entries("末体")},
+			want:    `[{"kanji":"未","added":[{"kan":"体","new_pos":2}],"removed":[{"kan":"本","old_pos":2}]}]`,
+		},
+		{
+			name:    "added and removed kanji",
+			old:     map[string]EntryList{"未": entries("末")},
+			updated: map[string]EntryList{"本": entries("体")},
+			want:    `[{"kanji":"未","removed":[{"kan":"末","old_pos":1}]},{"kanji":"本","added":[{"kan":"体","new_pos":1}]}]`,
+		},
+		{
+			name:    "shifted isn't moved",
+			old:     map[string]EntryList{"未": entries("末本")},
+			updated: map[string]EntryList{"未": entries("体末本")},
+			want:    `[{"kanji":"未","added":[{"kan":"体","new_pos":1}]}]`,
+		},
+		{
+			name:    "moved",
+			old:     map[string]EntryList{"未": entries("末本体")},
+			updated: map[string]EntryList{"未": entries("本体末")},
+			want:    `[{"kanji":"未","moved":[{"kan":"末","old_pos":1,"new_pos":3}]}]`,
+		},
+		{
+			name:      "rescored",
+			old:       map[string]EntryList{"未": scored("末本", 0.9, 0.5)},
+			updated:   map[string]EntryList{"未": scored("末本", 0.9, 0.25)},
+			oldScored: true,
+			newScored: true,
+			want:      `[{"kanji":"未","rescored":[{"kan":"本","old_pos":2,"new_pos":2,"old_score":0.5,"new_score":0.25}]}]`,
+		},
+		{
+			name:      "unscored side isn't rescored",
+			old:       map[string]EntryList{"未": entries("末本")},
+			updated:   map[string]EntryList{"未": scored("末本", 0.9, 0.25)},
+			newScored: true,
+			want:      `[]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(Diff(tc.old, tc.updated, tc.oldScored, tc.newScored))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	diffs := Diff(
+		map[string]EntryList{"未": {{Kan: "末", Score: 0.5}, {Kan: "本", Score: 0.25}}},
+		map[string]EntryList{"未": {{Kan: "本", Score: 0.75}, {Kan: "体", Score: 0.1}}},
+		true, true)
+	var buf bytes.Buffer
+	if err := WriteDiff(&buf, diffs); err != nil {
+		t.Fatal(err)
+	}
+	want := "未: +1 -1 ~1\n" +
+		"  - 末  #1  0.500\n" +
+		"  + 体  #2  0.100\n" +
+		"  ~ 本  #2 → #1  0.250 → 0.750 (+0.500)\n"
+	if buf.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestLoadOutput(t *testing.T) {
+	for _, tc := range []struct {
+		name, json string
+		want       map[string]EntryList
+		scored     bool
+		ok         bool
+	}{
+		{"compact", `{"未": "末本"}`, map[string]EntryList{"未": entries("末本")}, false, true},
+		{"scored", `{"未": [{"kan": "末", "score": 0.5}]}`, map[string]EntryList{"未": {{Kan: "末", Score: 0.5}}}, true, true},
+		{"empty", `{}`, map[string]EntryList{}, true, true},
+		{"mixed", `{"未": "末", "本": [{"kan": "体", "score": 0.5}]}`, nil, false, false},
+		{"not an object", `["未"]`, nil, false, false},
+		{"invalid", `{"未": `, nil, false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "output.json")
+			if err := os.WriteFile(path, []byte(tc.json), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, scored, err := LoadOutput(path)
+			if !tc.ok {
+				if err == nil {
+					t.Errorf("no error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if scored != tc.scored || !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, %v, want %v, %v", got, scored, tc.want, tc.scored)
+			}
+		})
+	}
+}
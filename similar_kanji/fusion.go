@@ -0,0 +1,104 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"fmt"
+)
+
+// Fusion is a strategy for combining the scores that several sources gave to
+// the same pair of kanji.  Each source's score is multiplied by its weight
+// before being combined.
+type Fusion string
+
+const (
+	// FusionMax takes the highest weighted score.
+	FusionMax Fusion = "max"
+
+	// FusionWeightedMean averages the weighted scores over every source in the
+	// index, counting sources that don't list the pair as 0.
+	FusionWeightedMean Fusion = "weighted_mean"
+
+	// FusionNoisyOr treats each weighted score as an independent probability
+	// that the pair is similar, so agreement between sources gives a higher
+	// score than any one of them alone.
+	FusionNoisyOr Fusion = "noisy_or"
+)
+
+func ParseFusion(s string) (Fusion, error) {
+	switch f := Fusion(s); f {
+	case FusionMax, FusionWeightedMean, FusionNoisyOr:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown fusion strategy %q", s)
+}
+
+func (idx *Index) weight(source string) float32 {
+	if w, ok := idx.Weights[source]; ok {
+		return w
+	}
+	return 1.0
+}
+
+func (idx *Index) fuse(sources []SourceScore) float32 {
+	switch idx.Fusion {
+	case FusionWeightedMean:
+		var sum, total float32
+		for _, s := range sources {
+			sum += idx.weight(s.Source) * s.Score
+		}
+		for _, name := range idx.sources {
+			total += idx.weight(name)
+		}
+		if total == 0 {
+			return 0
+		}
+		return sum / total
+
+	case FusionNoisyOr:
+		notSimilar := float32(1.0)
+		for _, s := range sources {
+			p := idx.weight(s.Source) * s.Score
+			if p > 1 {
+				p = 1
+			} else if p < 0 {
+				p = 0
+			}
+			notSimilar *= 1 - p
+		}
+		return 1 - notSimilar
+
+	default:
+		var ret float32
+		for _, s := range sources {
+			if score := idx.weight(s.Source) * s.Score; score > ret {
+				ret = score
+			}
+		}
+		return ret
+	}
+}
+
+// Fuse recomputes the score of every entry from its sources, except for
+// entries whose score was pinned by an override.
+func (idx *Index) Fuse() {
+	for _, entries := range idx.data {
+		for i := range entries {
+			if !entries[i].Pinned {
+				entries[i].Score = idx.fuse(entries[i].Sources)
+			}
+		}
+	}
+}
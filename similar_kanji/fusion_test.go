@@ -0,0 +1,82 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFuse(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		fusion  Fusion
+		weights map[string]float32
+		empty   bool
+		want    float32
+	}{
+		{"max", FusionMax, nil, false, 0.8},
+		{"max weighted", FusionMax, map[string]float32{"a": 0.5}, false, 0.5},
+		{"max weight 0", FusionMax, map[string]float32{"a": 0, "b": 0}, false, 0},
+		{"weighted_mean", FusionWeightedMean, nil, false, (0.8 + 0.5) / 3},
+		{"weighted_mean weighted", FusionWeightedMean, map[string]float32{"a": 2}, false, (1.6 + 0.5) / 4},
+		{"weighted_mean weight 0 not counted", FusionWeightedMean, map[string]float32{"c": 0}, false, (0.8 + 0.5) / 2},
+		{"weighted_mean every weight 0", FusionWeightedMean, map[string]float32{"a": 0, "b": 0, "c": 0}, false, 0},
+		{"weighted_mean empty source not counted", FusionWeightedMean, nil, true, (0.8 + 0.5) / 3},
+		{"noisy_or", FusionNoisyOr, nil, false, 1 - 0.2*0.5},
+		{"noisy_or clamped", FusionNoisyOr, map[string]float32{"a": 2}, false, 1},
+		{"noisy_or weight 0", FusionNoisyOr, map[string]float32{"a": 0}, false, 0.5},
+		{"noisy_or empty source", FusionNoisyOr, nil, true, 1 - 0.2*0.5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			idx := Create()
+			idx.Fusion = tc.fusion
+			for source, w := range tc.weights {
+				idx.Weights[source] = w
+			}
+			idx.Add("a", "未", "末", 0.8)
+			idx.Add("b", "未", "末", 0.5)
+			idx.Add("c", "未", "本", 0.6)
+			if tc.empty {
+				idx.AddScored("empty", map[string]EntryList{}, 0)
+			}
+			idx.Sort()
+
+			for _, e := range idx.Scored()["未"] {
+				if e.Kan != "末" {
+					continue
+				}
+				if math.Abs(float64(e.Score-tc.want)) > 1e-6 {
+					t.Errorf("got %v, want %v", e.Score, tc.want)
+				}
+				return
+			}
+			t.Errorf("未→末 isn't in the index")
+		})
+	}
+}
+
+func TestParseFusion(t *testing.T) {
+	for _, s := range []string{"max", "weighted_mean", "noisy_or"} {
+		if f, err := ParseFusion(s); err != nil || string(f) != s {
+			t.Errorf("ParseFusion(%q) = %q, %v", s, f, err)
+		}
+	}
+	for _, s := range []string{"", "mean", "MAX"} {
+		if _, err := ParseFusion(s); err == nil {
+			t.Errorf("ParseFusion(%q) didn't fail", s)
+		}
+	}
+}
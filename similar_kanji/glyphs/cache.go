@@ -0,0 +1,127 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glyphs
+
+import (
+	"encoding/gob"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// Cache keeps rendered bitmaps and pair scores between runs.  Entries are keyed
+// by the hash of the font files and the bitmap size, so changing either doesn't
+// reuse stale results.
+type Cache struct {
+	// Bitmaps maps "fonthash/size" to each character's pixels.  An empty
+	// slice means the font has no glyph for the character.
+	Bitmaps map[string]map[string][]byte
+
+	// Scores maps "fonthash,fonthash/size" to each pair's score.  Pairs are
+	// keyed with the lower codepoint first.
+	Scores map[string]map[string]float32
+
+	path  string
+	mu    sync.Mutex
+	dirty bool
+}
+
+// LoadCache reads a cache file, or returns an empty cache if it doesn't exist
+// yet.  An empty path gives a cache that is never saved.
+func LoadCache(path string) (*Cache, error) {
+	c := &Cache{
+		Bitmaps: map[string]map[string][]byte{},
+		Scores:  map[string]map[string]float32{},
+		path:    path,
+	}
+	if path == "" {
+		return c, nil
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := gob.NewDecoder(f).Decode(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Save writes the cache back to its file if anything was added.
+func (c *Cache) Save() error {
+	if c.path == "" || !c.dirty {
+		return nil
+	}
+	tmp := c.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(c); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+func (c *Cache) bitmap(key, char string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pix, ok := c.Bitmaps[key][char]
+	return pix, ok
+}
+
+func (c *Cache) setBitmap(key, char string, pix []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Bitmaps[key] == nil {
+		c.Bitmaps[key] = map[string][]byte{}
+	}
+	c.Bitmaps[key][char] = pix
+	c.dirty = true
+}
+
+func pairKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+	return a + b
+}
+
+func (c *Cache) score(key, a, b string) (float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	score, ok := c.Scores[key][pairKey(a, b)]
+	return score, ok
+}
+
+func (c *Cache) setScore(key, a, b string, score float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Scores[key] == nil {
+		c.Scores[key] = map[string]float32{}
+	}
+	c.Scores[key][pairKey(a, b)] = score
+	c.dirty = true
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package glyphs scores how alike kanji look by rendering them with local font
+// files and comparing the bitmaps.
+package glyphs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Glyphs are drawn at this size before being cropped and scaled down, so the
+// scaling smooths out the rasterization.
+const renderSize = 128
+
+// Font is a font file that kanji can be rendered with.
+type Font struct {
+	Name string
+
+	// Hash identifies the contents of the file for the cache.
+	Hash string
+
+	font *sfnt.Font
+	face font.Face
+	buf  sfnt.Buffer
+}
+
+// LoadFont reads a TrueType or OpenType font file.  WOFF files aren't
+// supported.
+func LoadFont(filename string) (*Font, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	f, err := opentype.Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    renderSize,
+		DPI:     72,
+		Hinting: font.HintingNone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	hash := sha256.Sum256(b)
+	return &Font{
+		Name: filepath.Base(filename),
+		Hash: hex.EncodeToString(hash[:8]),
+		font: f,
+		face: face,
+	}, nil
+}
+
+// Bitmap is a glyph cropped to the ink and scaled to fit a Size×Size square,
+// so kanji are compared by their shape rather than their position and size in
+// the em box.
+type Bitmap struct {
+	Size int
+	Pix  []uint8
+}
+
+func (b *Bitmap) check() error {
+	if b.Size <= 0 || len(b.Pix) != b.Size*b.Size {
+		return fmt.Errorf("%d pixels in a %d×%d bitmap", len(b.Pix), b.Size, b.Size)
+	}
+	return nil
+}
+
+// Render draws a kanji and normalizes it to a bitmap of the given size.
+// Returns nil if the font has no glyph for the character.
+func (f *Font) Render(char string, size int) (*Bitmap, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("bad bitmap size %d", size)
+	}
+	r, n := utf8.DecodeRuneInString(char)
+	if n != len(char) {
+		return nil, fmt.Errorf("%q is not a single character", char)
+	}
+	if idx, err := f.font.GlyphIndex(&f.buf, r); err != nil {
+		return nil, err
+	} else if idx == 0 {
+		return nil, nil
+	}
+
+	// Leave plenty of room around the glyph in case it overflows the em box.
+	canvas := image.NewGray(image.Rect(0, 0, renderSize*2, renderSize*2))
+	d := font.Drawer{
+		Dst:  canvas,
+		Src:  image.NewUniform(color.Gray{255}),
+		Face: f.face,
+		Dot:  fixed.P(renderSize/2, renderSize*3/2),
+	}
+	d.DrawString(char)
+
+	ink := inkBounds(canvas)
+	if ink.Empty() {
+		return nil, nil
+	}
+
+	// Scale the longer side to fit and centre the shorter one.
+	scaled := ink
+	if ink.Dx() > ink.Dy() {
+		h := ink.Dy() * size / ink.Dx()
+		scaled = image.Rect(0, (size-h)/2, size, (size-h)/2+h)
+	} else {
+		w := ink.Dx() * size / ink.Dy()
+		scaled = image.Rect((size-w)/2, 0, (size-w)/2+w, size)
+	}
+	out := image.NewGray(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(out, scaled, canvas, ink, draw.Src, nil)
+	return &Bitmap{size, out.Pix}, nil
+}
+
+func inkBounds(img *image.Gray) image.Rectangle {
+	b := img.Bounds()
+	ret := image.Rectangle{}
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if img.GrayAt(x, y).Y != 0 {
+				ret = ret.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return ret
+}
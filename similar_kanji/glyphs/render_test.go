@@ -0,0 +1,135 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glyphs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func loadGoFont(t *testing.T) *Font {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "Go-Regular.ttf")
+	if err := os.WriteFile(path, goregular.TTF, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := LoadFont(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// extent returns the first and last rows and columns with ink.
+func extent(b *Bitmap) (top, bottom, left, right int) {
+	top, left = b.Size, b.Size
+	bottom, right = -1, -1
+	for y := 0; y < b.Size; y++ {
+		for x := 0; x < b.Size; x++ {
+			if b.Pix[y*b.Size+x] < 64 {
+				continue
+			}
+			top, bottom = minInt(top, y), maxInt(bottom, y)
+			left, right = minInt(left, x), maxInt(right, x)
+		}
+	}
+	return
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func TestRenderNormalizes(t *testing.T) {
+	f := loadGoFont(t)
+	for _, tc := range []struct {
+		char string
+		tall bool
+	}{
+		{"I", true},
+		{"l", true},
+		{"-", false},
+		{"_", false},
+	} {
+		b, err := f.Render(tc.char, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b == nil || b.Size != 32 || len(b.Pix) != 32*32 {
+			t.Fatalf("%q: got %+v, want a 32×32 bitmap", tc.char, b)
+		}
+
+		// The longer side fills the bitmap and the shorter one is centred.
+		top, bottom, left, right := extent(b)
+		long, before, after := [2]int{top, bottom}, left, 31-right
+		if !tc.tall {
+			long, before, after = [2]int{left, right}, top, 31-bottom
+		}
+		if long[0] > 1 || long[1] < 30 {
+			t.Errorf("%q: ink from %d to %d, want it to fill the bitmap", tc.char, long[0], long[1])
+		}
+		if d := before - after; d < -1 || d > 1 {
+			t.Errorf("%q: margins %d and %d, want it centred", tc.char, before, after)
+		}
+	}
+
+	// Shapes are compared regardless of where they are in the em box, so a
+	// hyphen looks more like an underscore than like an I.
+	render := func(char string) *Bitmap {
+		b, err := f.Render(char, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	hyphen := render("-")
+	same, err := SSIM(hyphen, render("_"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	different, err := SSIM(hyphen, render("I"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same <= different {
+		t.Errorf("SSIM of - and _ = %v, - and I = %v", same, different)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	f := loadGoFont(t)
+	if b, err := f.Render("未", 32); b != nil || err != nil {
+		t.Errorf("missing glyph gave %v, %v, want nil, nil", b, err)
+	}
+	if _, err := f.Render("ab", 32); err == nil {
+		t.Errorf("no error for two characters")
+	}
+	if _, err := f.Render("a", 0); err == nil {
+		t.Errorf("no error for size 0")
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glyphs
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/davidsansome/tsurukame/similar_kanji"
+)
+
+// Scorer compares kanji rendered in one or more fonts.
+type Scorer struct {
+	Fonts []*Font
+
+	// Size is the width and height of the normalized bitmaps.
+	Size int
+
+	Cache *Cache
+
+	// bitmaps[i][char] is the bitmap of char in Fonts[i].
+	bitmaps []map[string]*Bitmap
+}
+
+func NewScorer(fonts []*Font, size int, cache *Cache) *Scorer {
+	s := &Scorer{Fonts: fonts, Size: size, Cache: cache}
+	for range fonts {
+		s.bitmaps = append(s.bitmaps, map[string]*Bitmap{})
+	}
+	return s
+}
+
+func (s *Scorer) bitmapKey(f *Font) string {
+	return fmt.Sprintf("%s/%d", f.Hash, s.Size)
+}
+
+func (s *Scorer) scoreKey() string {
+	var hashes []string
+	for _, f := range s.Fonts {
+		hashes = append(hashes, f.Hash)
+	}
+	return fmt.Sprintf("%s/%d", strings.Join(hashes, ","), s.Size)
+}
+
+// Render draws every character in every font, using the cache where it can.
+// Fonts aren't safe for concurrent use, so this must be done before scoring.
+func (s *Scorer) Render(chars []string) error {
+	for i, f := range s.Fonts {
+		key := s.bitmapKey(f)
+		for _, char := range chars {
+			if _, ok := s.bitmaps[i][char]; ok {
+				continue
+			}
+			// Cached bitmaps of the wrong size are drawn again.
+			if pix, ok := s.Cache.bitmap(key, char); ok && (len(pix) == 0 || len(pix) == s.Size*s.Size) {
+				s.bitmaps[i][char] = nil
+				if len(pix) != 0 {
+					s.bitmaps[i][char] = &Bitmap{s.Size, pix}
+				}
+				continue
+			}
+
+			b, err := f.Render(char, s.Size)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %w", f.Name, char, err)
+			}
+			s.bitmaps[i][char] = b
+			var pix []byte
+			if b != nil {
+				pix = b.Pix
+			}
+			s.Cache.setBitmap(key, char, pix)
+		}
+	}
+	return nil
+}
+
+// Similarity is the SSIM of two rendered kanji averaged over the fonts that
+// have both of them.  Returns false if no font has both.
+func (s *Scorer) Similarity(a, b string) (float32, bool, error) {
+	key := s.scoreKey()
+	if score, ok := s.Cache.score(key, a, b); ok {
+		return score, score >= 0, nil
+	}
+
+	var total float64
+	count := 0
+	for i, f := range s.Fonts {
+		ba, bb := s.bitmaps[i][a], s.bitmaps[i][b]
+		if ba == nil || bb == nil {
+			continue
+		}
+		ssim, err := SSIM(ba, bb)
+		if err != nil {
+			return 0, false, fmt.Errorf("%s: %s and %s: %w", f.Name, a, b, err)
+		}
+		total += ssim
+		count++
+	}
+
+	// Pairs that can't be compared are cached as -1.
+	score := float32(-1)
+	if count != 0 {
+		score = float32(total / float64(count))
+		if score < 0 {
+			score = 0
+		}
+	}
+	s.Cache.setScore(key, a, b, score)
+	return score, score >= 0, nil
+}
+
+// Options controls which pairs ScoreAll returns.
+type Options struct {
+	// Pairs with a similarity at or below Threshold are dropped.
+	Threshold float32
+
+	// Limit is the maximum number of similar kanji kept for each kanji, or 0
+	// for no limit.
+	Limit int
+}
+
+// ScoreAll scores the candidate similar kanji of each kanji, and returns them
+// in the scored format read by similar_kanji.Index.AddScoredFile.  The
+// characters must have been rendered first.
+func (s *Scorer) ScoreAll(candidates map[string][]string, opts Options) (map[string]similar_kanji.EntryList, error) {
+	var chars []string
+	for c := range candidates {
+		chars = append(chars, c)
+	}
+	sort.Strings(chars)
+
+	work := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ret := map[string]similar_kanji.EntryList{}
+	var firstErr error
+
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for char := range work {
+				var entries similar_kanji.EntryList
+				for _, other := range candidates[char] {
+					if other == char {
+						continue
+					}
+					score, ok, err := s.Similarity(char, other)
+					if err != nil {
+						mu.Lock()
+						if firstErr == nil {
+							firstErr = err
+						}
+						mu.Unlock()
+						break
+					}
+					if ok && score > opts.Threshold {
+						entries = append(entries, similar_kanji.Entry{Kan: other, Score: score})
+					}
+				}
+				if len(entries) == 0 {
+					continue
+				}
+				sort.Sort(entries)
+				if opts.Limit > 0 && len(entries) > opts.Limit {
+					entries = entries[:opts.Limit]
+				}
+				mu.Lock()
+				ret[char] = entries
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, char := range chars {
+		work <- char
+	}
+	close(work)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return ret, nil
+}
+
+// AllPairs returns candidates that compare every character with every other.
+func AllPairs(chars []string) map[string][]string {
+	ret := map[string][]string{}
+	for _, c := range chars {
+		ret[c] = chars
+	}
+	return ret
+}
@@ -0,0 +1,81 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glyphs
+
+import "fmt"
+
+const (
+	ssimWindow = 8
+	ssimStep   = 4
+
+	// Stabilizing constants from Wang et al. for 8-bit images.
+	ssimC1 = (0.01 * 255) * (0.01 * 255)
+	ssimC2 = (0.03 * 255) * (0.03 * 255)
+)
+
+// SSIM is the mean structural similarity of two bitmaps of the same size,
+// computed over overlapping windows.  1 means identical.  Windows that are
+// blank in both bitmaps are skipped, otherwise the background around thin
+// glyphs would make everything look alike.
+func SSIM(a, b *Bitmap) (float64, error) {
+	if a.Size != b.Size {
+		return 0, fmt.Errorf("bitmaps are %d and %d pixels wide", a.Size, b.Size)
+	}
+	for _, bm := range []*Bitmap{a, b} {
+		if err := bm.check(); err != nil {
+			return 0, err
+		}
+	}
+	size := a.Size
+	window := ssimWindow
+	if size < window {
+		window = size
+	}
+	n := float64(window * window)
+
+	var total float64
+	count := 0
+	for y := 0; y+window <= size; y += ssimStep {
+		for x := 0; x+window <= size; x += ssimStep {
+			var sumA, sumB, sumAA, sumBB, sumAB float64
+			for wy := y; wy < y+window; wy++ {
+				for wx := x; wx < x+window; wx++ {
+					pa := float64(a.Pix[wy*size+wx])
+					pb := float64(b.Pix[wy*size+wx])
+					sumA += pa
+					sumB += pb
+					sumAA += pa * pa
+					sumBB += pb * pb
+					sumAB += pa * pb
+				}
+			}
+			if sumA == 0 && sumB == 0 {
+				continue
+			}
+			meanA, meanB := sumA/n, sumB/n
+			varA := sumAA/n - meanA*meanA
+			varB := sumBB/n - meanB*meanB
+			cov := sumAB/n - meanA*meanB
+
+			total += ((2*meanA*meanB + ssimC1) * (2*cov + ssimC2)) /
+				((meanA*meanA + meanB*meanB + ssimC1) * (varA + varB + ssimC2))
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	return total / float64(count), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glyphs
+
+import (
+	"math"
+	"testing"
+)
+
+// bitmap draws a size×size bitmap where ink(x, y) is true.
+func bitmap(size int, ink func(x, y int) bool) *Bitmap {
+	b := &Bitmap{size, make([]uint8, size*size)}
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if ink(x, y) {
+				b.Pix[y*size+x] = 255
+			}
+		}
+	}
+	return b
+}
+
+func ssim(t *testing.T, a, b *Bitmap) float64 {
+	t.Helper()
+	s, err := SSIM(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSSIM(t *testing.T) {
+	cross := bitmap(32, func(x, y int) bool { return x == 16 || y == 16 })
+	box := bitmap(32, func(x, y int) bool { return x == 4 || x == 27 || y == 4 || y == 27 })
+	thick := bitmap(32, func(x, y int) bool { return x >= 15 && x <= 17 || y >= 15 && y <= 17 })
+	blank := bitmap(32, func(x, y int) bool { return false })
+
+	for _, b := range []*Bitmap{cross, box, thick} {
+		if s := ssim(t, b, b); math.Abs(s-1) > 1e-9 {
+			t.Errorf("SSIM of a bitmap with itself = %v, want 1", s)
+		}
+	}
+	for _, pair := range [][2]*Bitmap{{cross, box}, {cross, thick}, {box, thick}, {cross, blank}} {
+		ab, ba := ssim(t, pair[0], pair[1]), ssim(t, pair[1], pair[0])
+		if ab != ba {
+			t.Errorf("SSIM isn't symmetric: %v and %v", ab, ba)
+		}
+		if ab >= 1 {
+			t.Errorf("SSIM of different bitmaps = %v, want less than 1", ab)
+		}
+	}
+	if a, b := ssim(t, cross, thick), ssim(t, cross, box); a <= b {
+		t.Errorf("thick cross (%v) should be closer to the cross than the box (%v)", a, b)
+	}
+	if s := ssim(t, blank, blank); s != 0 {
+		t.Errorf("SSIM of blank bitmaps = %v, want 0", s)
+	}
+}
+
+func TestSSIMSizes(t *testing.T) {
+	a := bitmap(32, func(x, y int) bool { return x == y })
+	for _, b := range []*Bitmap{
+		bitmap(16, func(x, y int) bool { return x == y }),
+		{32, make([]uint8, 10)},
+		{0, nil},
+	} {
+		if _, err := SSIM(a, b); err == nil {
+			t.Errorf("no error for a %d×%d bitmap with %d pixels", b.Size, b.Size, len(b.Pix))
+		}
+	}
+}
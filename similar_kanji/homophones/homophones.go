@@ -0,0 +1,268 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package homophones groups kanji that sound alike: kanji that share a
+// primary on'yomi, and phonetic-component (keisei) families of kanji that
+// share both a component and the on'yomi it gives them, like 青, 清, 晴 and 精.
+package homophones
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/proto"
+	"github.com/davidsansome/tsurukame/utils"
+)
+
+// Member is one kanji in a group or family.
+type Member struct {
+	SubjectID int64  `json:"subject_id"`
+	Japanese  string `json:"japanese"`
+	Level     int32  `json:"level"`
+}
+
+// Group is the kanji that share a primary on'yomi.
+type Group struct {
+	Reading string   `json:"reading"`
+	Kanji   []Member `json:"kanji"`
+}
+
+// Family is the kanji that contain a component and share an on'yomi.
+type Family struct {
+	Component   string   `json:"component"`
+	ComponentID int64    `json:"component_id"`
+	Reading     string   `json:"reading"`
+	Kanji       []Member `json:"kanji"`
+
+	// Share is the fraction of the kanji containing the component that have
+	// the reading.
+	Share float64 `json:"share"`
+}
+
+// Options controls which phonetic families Families returns.
+type Options struct {
+	// MinSize is the smallest number of kanji in a family.
+	MinSize int
+
+	// MinShare is the smallest fraction of the kanji containing a component
+	// that must share the reading.  This keeps semantic components like 氵,
+	// which appear in many kanji with unrelated readings, out of the families.
+	MinShare float64
+}
+
+func member(s *proto.Subject) Member {
+	return Member{s.GetId(), s.GetJapanese(), s.GetLevel()}
+}
+
+func sortMembers(m []Member) {
+	sort.Slice(m, func(i, j int) bool {
+		if m[i].Level != m[j].Level {
+			return m[i].Level < m[j].Level
+		}
+		return m[i].SubjectID < m[j].SubjectID
+	})
+}
+
+// PrimaryOnyomi returns a kanji's primary on'yomi in hiragana, or false if
+// its primary reading is a kun'yomi or nanori.
+func PrimaryOnyomi(s *proto.Subject) (string, bool) {
+	for _, r := range s.Readings {
+		if r.GetIsPrimary() && r.GetType() == proto.Reading_ONYOMI {
+			return utils.ToHiragana(strings.TrimSpace(r.GetReading())), true
+		}
+	}
+	return "", false
+}
+
+func onyomi(s *proto.Subject) []string {
+	seen := map[string]bool{}
+	var ret []string
+	for _, r := range s.Readings {
+		if r.GetType() != proto.Reading_ONYOMI {
+			continue
+		}
+		reading := utils.ToHiragana(strings.TrimSpace(r.GetReading()))
+		if !seen[reading] {
+			seen[reading] = true
+			ret = append(ret, reading)
+		}
+	}
+	return ret
+}
+
+// SameReading groups the kanji subjects by their primary on'yomi.  Only
+// readings shared by more than one kanji are returned, ordered by reading.
+func SameReading(subjects []*proto.Subject) []Group {
+	groups := map[string][]Member{}
+	for _, s := range subjects {
+		if s.Kanji == nil {
+			continue
+		}
+		if reading, ok := PrimaryOnyomi(s); ok {
+			groups[reading] = append(groups[reading], member(s))
+		}
+	}
+
+	var ret []Group
+	for reading, kanji := range groups {
+		if len(kanji) < 2 {
+			continue
+		}
+		sortMembers(kanji)
+		ret = append(ret, Group{reading, kanji})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Reading < ret[j].Reading })
+	return ret
+}
+
+// Families finds the phonetic families of the kanji subjects.  A family is
+// the kanji whose component_subject_ids contain the same radical and which
+// have an on'yomi in common.  Radicals without characters are skipped.
+// Families are ordered by component, then reading.
+func Families(subjects []*proto.Subject, opts Options) []Family {
+	minSize := opts.MinSize
+	if minSize < 2 {
+		minSize = 2
+	}
+
+	radicals := map[int64]string{}
+	for _, s := range subjects {
+		if s.Radical != nil && s.GetJapanese() != "" {
+			radicals[s.GetId()] = s.GetJapanese()
+		}
+	}
+
+	type key struct {
+		component int64
+		reading   string
+	}
+	containing := map[int64]int{}
+	families := map[key][]Member{}
+	for _, s := range subjects {
+		if s.Kanji == nil {
+			continue
+		}
+		readings := onyomi(s)
+		seen := map[int64]bool{}
+		for _, id := range s.ComponentSubjectIds {
+			if _, ok := radicals[id]; !ok || seen[id] {
+				continue
+			}
+			seen[id] = true
+			containing[id]++
+			for _, reading := range readings {
+				k := key{id, reading}
+				families[k] = append(families[k], member(s))
+			}
+		}
+	}
+
+	var ret []Family
+	for k, kanji := range families {
+		share := float64(len(kanji)) / float64(containing[k.component])
+		if len(kanji) < minSize || share < opts.MinShare {
+			continue
+		}
+		sortMembers(kanji)
+		ret = append(ret, Family{
+			Component:   radicals[k.component],
+			ComponentID: k.component,
+			Reading:     k.reading,
+			Kanji:       kanji,
+			Share:       share,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		a, b := ret[i], ret[j]
+		if a.ComponentID != b.ComponentID {
+			return a.ComponentID < b.ComponentID
+		}
+		return a.Reading < b.Reading
+	})
+	return ret
+}
+
+// Report describes the result of ApplyToSubjects.
+type Report struct {
+	// Number of kanji subjects given same-reading kanji.
+	SameReading int `json:"same_reading"`
+
+	// Number of kanji subjects given a phonetic family.
+	Families int `json:"families"`
+}
+
+// ApplyToSubjects replaces the same-reading kanji and phonetic family of every
+// kanji subject.  A kanji in more than one family gets the largest, preferring
+// the family of its primary on'yomi, then the one listed first.
+func ApplyToSubjects(subjects []*proto.Subject, groups []Group, families []Family) *Report {
+	others := func(kanji []Member, id int64) []int64 {
+		var ret []int64
+		for _, m := range kanji {
+			if m.SubjectID != id {
+				ret = append(ret, m.SubjectID)
+			}
+		}
+		return ret
+	}
+
+	groupOf := map[int64]*Group{}
+	for i := range groups {
+		for _, m := range groups[i].Kanji {
+			groupOf[m.SubjectID] = &groups[i]
+		}
+	}
+	familiesOf := map[int64][]*Family{}
+	for i := range families {
+		for _, m := range families[i].Kanji {
+			familiesOf[m.SubjectID] = append(familiesOf[m.SubjectID], &families[i])
+		}
+	}
+
+	report := &Report{}
+	for _, s := range subjects {
+		if s.Kanji == nil {
+			continue
+		}
+		id := s.GetId()
+
+		s.Kanji.SameReadingKanjiIds = nil
+		if g, ok := groupOf[id]; ok {
+			s.Kanji.SameReadingKanjiIds = others(g.Kanji, id)
+			report.SameReading++
+		}
+
+		primary, _ := PrimaryOnyomi(s)
+		var best *Family
+		for _, f := range familiesOf[id] {
+			switch {
+			case best == nil:
+			case len(f.Kanji) > len(best.Kanji):
+			case len(f.Kanji) == len(best.Kanji) && f.Reading == primary && best.Reading != primary:
+			default:
+				continue
+			}
+			best = f
+		}
+
+		s.Kanji.PhoneticComponent = nil
+		s.Kanji.PhoneticFamilyKanjiIds = nil
+		if best != nil {
+			component := best.Component
+			s.Kanji.PhoneticComponent = &component
+			s.Kanji.PhoneticFamilyKanjiIds = others(best.Kanji, id)
+			report.Families++
+		}
+	}
+	return report
+}
@@ -0,0 +1,209 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ids scores the similarity of kanji by the components they are made
+// of, using Ideographic Description Sequences in the format of the CHISE and
+// cjkvi-ids projects.
+package ids
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// Number of components taken by each Ideographic Description Character.
+var operatorArity = map[rune]int{
+	'⿰': 2, '⿱': 2, '⿲': 3, '⿳': 3,
+	'⿴': 2, '⿵': 2, '⿶': 2, '⿷': 2,
+	'⿸': 2, '⿹': 2, '⿺': 2, '⿻': 2,
+	'⿼': 2, '⿽': 2, '⿾': 1, '⿿': 1,
+	'㇯': 2,
+}
+
+// Node is one node of a component tree.  Leaves have a Char and no Op.
+type Node struct {
+	Char     string
+	Op       rune
+	Children []*Node
+}
+
+func (n *Node) String() string {
+	if n.Op == 0 {
+		return n.Char
+	}
+	var sb strings.Builder
+	sb.WriteRune(n.Op)
+	for _, c := range n.Children {
+		sb.WriteString(c.String())
+	}
+	return sb.String()
+}
+
+// Table maps each character to its Ideographic Description Sequence.
+type Table map[string]*Node
+
+// ParseSequence parses a single IDS like "⿰氵則".  Unencoded components like
+// "{1}" or "&CDP-8BBE;" are kept as opaque leaves.
+func ParseSequence(s string) (*Node, error) {
+	n, rest, err := parseNode(s)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("trailing %q after %q", rest, s[:len(s)-len(rest)])
+	}
+	return n, nil
+}
+
+func parseNode(s string) (*Node, string, error) {
+	if s == "" {
+		return nil, "", fmt.Errorf("unexpected end of sequence")
+	}
+
+	switch s[0] {
+	case '{':
+		if end := strings.IndexByte(s, '}'); end != -1 {
+			return &Node{Char: s[:end+1]}, s[end+1:], nil
+		}
+		return nil, "", fmt.Errorf("unterminated %q", s)
+	case '&':
+		if end := strings.IndexByte(s, ';'); end != -1 {
+			return &Node{Char: s[:end+1]}, s[end+1:], nil
+		}
+		return nil, "", fmt.Errorf("unterminated %q", s)
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return nil, "", fmt.Errorf("invalid UTF-8 in %q", s)
+	}
+	rest := s[size:]
+	arity, ok := operatorArity[r]
+	if !ok {
+		return &Node{Char: string(r)}, rest, nil
+	}
+
+	n := &Node{Op: r}
+	for i := 0; i < arity; i++ {
+		var child *Node
+		var err error
+		if child, rest, err = parseNode(rest); err != nil {
+			return nil, "", err
+		}
+		n.Children = append(n.Children, child)
+	}
+	return n, rest, nil
+}
+
+// chooseSequence picks the IDS to use from the alternatives on one line.  Each
+// can be followed by the regions it applies to, like "⿱𠆢⿱戸口[GTJ]".  The
+// first one used in Japan is preferred, otherwise the first one.
+func chooseSequence(alternatives []string) string {
+	for _, a := range alternatives {
+		if i := strings.IndexByte(a, '['); i != -1 && strings.ContainsRune(a[i:], 'J') {
+			return a[:i]
+		}
+	}
+	a := alternatives[0]
+	if i := strings.IndexByte(a, '['); i != -1 {
+		return a[:i]
+	}
+	return a
+}
+
+// Parse reads an IDS file.  Each line is a codepoint, the character and one
+// or more sequences, separated by tabs.  Lines starting with ";;" or "#" are
+// comments.  Lines that can't be parsed are returned as errors and skipped.
+func Parse(r io.Reader) (Table, []error) {
+	t := Table{}
+	var errs []error
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, ";;") || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 3 {
+			errs = append(errs, fmt.Errorf("line %d: expected at least 3 fields, got %d", line, len(fields)))
+			continue
+		}
+
+		seq := strings.TrimPrefix(chooseSequence(fields[2:]), "^")
+		seq = strings.TrimSuffix(seq, "$")
+		n, err := ParseSequence(seq)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %s: %w", line, fields[1], err))
+			continue
+		}
+		t[fields[1]] = n
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return t, errs
+}
+
+func ParseFile(filename string) (Table, []error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer f.Close()
+
+	t, errs := Parse(f)
+	for i, err := range errs {
+		errs[i] = fmt.Errorf("%s: %w", filename, err)
+	}
+	return t, errs
+}
+
+// Decompose returns the full component tree of a character, expanding each
+// component with its own sequence until only atomic components are left.
+// Returns nil if the character isn't in the table.
+func (t Table) Decompose(char string) *Node {
+	if _, ok := t[char]; !ok {
+		return nil
+	}
+	return t.expand(&Node{Char: char}, map[string]bool{})
+}
+
+func (t Table) expand(n *Node, seen map[string]bool) *Node {
+	if n.Op != 0 {
+		ret := &Node{Op: n.Op}
+		for _, c := range n.Children {
+			ret.Children = append(ret.Children, t.expand(c, seen))
+		}
+		return ret
+	}
+
+	seq, ok := t[n.Char]
+	if !ok || seq.Op == 0 || seen[n.Char] {
+		// Atomic, or a cycle in the data.
+		return &Node{Char: n.Char}
+	}
+	seen[n.Char] = true
+	defer delete(seen, n.Char)
+
+	expanded := t.expand(seq, seen)
+	expanded.Char = n.Char
+	return expanded
+}
@@ -0,0 +1,224 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ids
+
+import (
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// feature is one component of a kanji and where it appears.
+type feature struct {
+	component string
+
+	// position is the path from the root to the component, like "⿰1⿱0" for
+	// the top of the right-hand side.
+	position string
+
+	// Components nearer the root are worth more: 1 for the root's children,
+	// 0.5 for their children and so on.
+	weight float64
+}
+
+func features(n *Node) []feature {
+	var ret []feature
+	var walk func(n *Node, position string, weight float64)
+	walk = func(n *Node, position string, weight float64) {
+		for i, c := range n.Children {
+			p := position + string(n.Op) + strconv.Itoa(i)
+			component := c.Char
+			if component == "" {
+				component = c.String()
+			}
+			ret = append(ret, feature{component, p, weight})
+			walk(c, p, weight/2)
+		}
+	}
+	walk(n, "", 1)
+	return ret
+}
+
+// similarity is the Dice coefficient of two kanji's features.  A component
+// shared at the same position counts fully, and a component shared at a
+// different position counts half.  Components are matched greedily, so the
+// shared weight is averaged over both directions to keep the score the same
+// whichever way round the kanji are given.
+func similarity(a, b []feature) float64 {
+	var totalA, totalB float64
+	for _, f := range a {
+		totalA += f.weight
+	}
+	for _, f := range b {
+		totalB += f.weight
+	}
+	if totalA+totalB == 0 {
+		return 0
+	}
+	return (sharedWeight(a, b) + sharedWeight(b, a)) / (totalA + totalB)
+}
+
+// sharedWeight matches each of a's features with the first unused feature of
+// b with the same component, preferring ones at the same position, and sums
+// the weights of the matches.
+func sharedWeight(a, b []feature) float64 {
+	used := make([]bool, len(b))
+	var shared float64
+	match := func(f feature, samePosition bool) bool {
+		for j, g := range b {
+			if used[j] || g.component != f.component || (g.position == f.position) != samePosition {
+				continue
+			}
+			used[j] = true
+			w := f.weight
+			if g.weight < w {
+				w = g.weight
+			}
+			if !samePosition {
+				w /= 2
+			}
+			shared += w
+			return true
+		}
+		return false
+	}
+
+	var unmatched []feature
+	for _, f := range a {
+		if !match(f, true) {
+			unmatched = append(unmatched, f)
+		}
+	}
+	for _, f := range unmatched {
+		match(f, false)
+	}
+	return shared
+}
+
+// Similarity scores two kanji by their shared components, from 0 (nothing in
+// common) to 1 (the same components in the same places).
+func (t Table) Similarity(a, b string) float64 {
+	na, nb := t.Decompose(a), t.Decompose(b)
+	if na == nil || nb == nil {
+		return 0
+	}
+	return similarity(features(na), features(nb))
+}
+
+// Match is one similar kanji found by ScoreAll.
+type Match struct {
+	Char  string
+	Score float64
+}
+
+// Options controls which pairs ScoreAll returns.
+type Options struct {
+	// Pairs with a similarity at or below Threshold are dropped.
+	Threshold float64
+
+	// Limit is the maximum number of similar kanji kept for each kanji, or 0
+	// for no limit.
+	Limit int
+
+	// Only, if not empty, restricts the kanji that get similar kanji.  Similar
+	// kanji can still come from any character in the CJK Unified Ideographs
+	// block.
+	Only map[string]bool
+}
+
+func isKanji(char string) bool {
+	r, size := utf8.DecodeRuneInString(char)
+	return size == len(char) && r >= 0x4e00 && r <= 0x9fff
+}
+
+// ScoreAll scores every pair of kanji that share at least one component.
+func (t Table) ScoreAll(opts Options) map[string][]Match {
+	all := map[string][]feature{}
+	byComponent := map[string][]string{}
+	var chars []string
+	for char := range t {
+		if !isKanji(char) && !opts.Only[char] {
+			continue
+		}
+		n := t.Decompose(char)
+		if n.Op == 0 {
+			continue
+		}
+		chars = append(chars, char)
+		all[char] = features(n)
+
+		seen := map[string]bool{}
+		for _, f := range all[char] {
+			if !seen[f.component] {
+				seen[f.component] = true
+				byComponent[f.component] = append(byComponent[f.component], char)
+			}
+		}
+	}
+	sort.Strings(chars)
+
+	work := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ret := map[string][]Match{}
+
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for char := range work {
+				var matches []Match
+				seen := map[string]bool{char: true}
+				for _, f := range all[char] {
+					for _, other := range byComponent[f.component] {
+						if seen[other] {
+							continue
+						}
+						seen[other] = true
+						if score := similarity(all[char], all[other]); score > opts.Threshold {
+							matches = append(matches, Match{other, score})
+						}
+					}
+				}
+				if len(matches) == 0 {
+					continue
+				}
+
+				sort.Slice(matches, func(i, j int) bool {
+					if matches[i].Score != matches[j].Score {
+						return matches[i].Score > matches[j].Score
+					}
+					return matches[i].Char < matches[j].Char
+				})
+				if opts.Limit > 0 && len(matches) > opts.Limit {
+					matches = matches[:opts.Limit]
+				}
+				mu.Lock()
+				ret[char] = matches
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, char := range chars {
+		if len(opts.Only) == 0 || opts.Only[char] {
+			work <- char
+		}
+	}
+	close(work)
+	wg.Wait()
+	return ret
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ids
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSequences = `U+6728	木	木
+U+6797	林	⿰木木
+U+6751	村	⿰木寸
+U+674F	杏	⿱木口
+U+5446	呆	⿱口木
+U+4F11	休	⿰亻木
+U+68EE	森	⿱木林
+U+53E3	口	口
+U+5BF8	寸	寸
+U+4EBB	亻	亻
+U+2F00	⼀	⿱木木
+`
+
+func testTable(t *testing.T) Table {
+	table, errs := Parse(strings.NewReader(testSequences))
+	if len(errs) != 0 {
+		t.Fatalf("Parse: %v", errs)
+	}
+	return table
+}
+
+func TestSimilarity(t *testing.T) {
+	table := testTable(t)
+	for _, tc := range []struct {
+		a, b string
+		want float64
+	}{
+		{"林", "林", 1},
+		{"村", "林", 0.5},
+		{"杏", "呆", 0.5},
+		{"休", "寸", 0},
+		{"林", "森", 0.3},
+		{"林", "木", 0},
+		{"林", "無", 0},
+	} {
+		for _, pair := range [][2]string{{tc.a, tc.b}, {tc.b, tc.a}} {
+			if got := table.Similarity(pair[0], pair[1]); math.Abs(got-tc.want) > 1e-9 {
+				t.Errorf("Similarity(%s, %s) = %v, want %v", pair[0], pair[1], got, tc.want)
+			}
+		}
+	}
+}
+
+func TestScoreAll(t *testing.T) {
+	table := testTable(t)
+	chars := func(matches []Match) []string {
+		var ret []string
+		for _, m := range matches {
+			ret = append(ret, m.Char)
+		}
+		return ret
+	}
+
+	for _, tc := range []struct {
+		name string
+		opts Options
+		want map[string][]string
+	}{
+		{
+			name: "threshold",
+			opts: Options{Threshold: 0.45},
+			want: map[string][]string{
+				"林": {"休", "村"},
+				"村": {"林"},
+				"休": {"林"},
+				"杏": {"呆"},
+				"呆": {"杏"},
+			},
+		},
+		{
+			name: "limit",
+			opts: Options{Threshold: 0.45, Limit: 1},
+			want: map[string][]string{
+				"林": {"休"},
+				"村": {"林"},
+				"休": {"林"},
+				"杏": {"呆"},
+				"呆": {"杏"},
+			},
+		},
+		{
+			name: "only",
+			opts: Options{Threshold: 0.45, Only: map[string]bool{"村": true, "休": true}},
+			want: map[string][]string{
+				"村": {"林"},
+				"休": {"林"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := map[string][]string{}
+			for char, matches := range table.ScoreAll(tc.opts) {
+				got[char] = chars(matches)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
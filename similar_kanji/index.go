@@ -0,0 +1,264 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package similar_kanji merges several datasets of visually similar kanji
+// into a single index.
+package similar_kanji
+
+import (
+	"sort"
+)
+
+const (
+	// DefaultThreshold is the minimum score an entry from a scored source
+	// needs to be added to the index, unless the source sets its own.
+	DefaultThreshold = 0.4
+)
+
+// SourceScore is the raw score one source gave to a pair of kanji.
+type SourceScore struct {
+	Source string  `json:"source"`
+	Score  float32 `json:"score"`
+
+	// Derived is set if the edge was added by Close rather than listed by the
+	// source itself.
+	Derived string `json:"derived,omitempty"`
+}
+
+// Entry is one similar kanji and its score.
+type Entry struct {
+	Kan     string        `json:"kan"`
+	Score   float32       `json:"score"`
+	Sources []SourceScore `json:"sources,omitempty"`
+
+	// Set by a pin override.  Pinned entries keep their score when the index
+	// is fused, and entries with a Position are moved there when it is sorted.
+	Pinned   bool   `json:"pinned,omitempty"`
+	Position int    `json:"position,omitempty"`
+	Note     string `json:"note,omitempty"`
+}
+
+// EntryList is the list of similar kanji for one kanji.  It sorts with the
+// highest score first, and entries with the same score by codepoint.
+type EntryList []Entry
+
+func (a EntryList) Len() int      { return len(a) }
+func (a EntryList) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a EntryList) Less(i, j int) bool {
+	if a[i].Score != a[j].Score {
+		return a[i].Score > a[j].Score
+	}
+	return a[i].Kan < a[j].Kan
+}
+
+type Index struct {
+	// Threshold is the minimum score for scored sources that don't set their
+	// own, and for edges added by Close.
+	Threshold float32
+
+	// Fusion combines the scores of pairs listed by more than one source.
+	Fusion Fusion
+
+	// Weights multiplies each source's scores before they are fused.  Sources
+	// not in the map have a weight of 1.
+	Weights map[string]float32
+
+	// Strict makes AddScoredFile and AddUnscoredFile fail with a
+	// ValidationError if a file has any problems.  Otherwise the bad kanji and
+	// entries are skipped and added to Problems.
+	Strict   bool
+	Problems []Problem
+
+	// Warnings are problems with sources that didn't stop them from loading.
+	Warnings []error
+
+	data    map[string]EntryList
+	sources []string
+}
+
+func Create() *Index {
+	return &Index{
+		Threshold: DefaultThreshold,
+		Fusion:    FusionMax,
+		Weights:   make(map[string]float32),
+		data:      make(map[string]EntryList),
+	}
+}
+
+func (idx *Index) addSourceName(source string) {
+	for _, name := range idx.sources {
+		if name == source {
+			return
+		}
+	}
+	idx.sources = append(idx.sources, source)
+}
+
+// Add records that source scored similarKanji as similar to kanji.  The
+// entry's overall score is the fusion of the scores from all its sources.
+func (idx *Index) Add(source, kanji, similarKanji string, score float32) {
+	idx.addSourceName(source)
+
+	for existingIdx, existingEntry := range idx.data[kanji] {
+		if similarKanji != existingEntry.Kan {
+			continue
+		}
+		e := &idx.data[kanji][existingIdx]
+
+		// If this source listed the pair already, keep its higher score.
+		for i, s := range e.Sources {
+			if s.Source == source {
+				if score > s.Score {
+					e.Sources[i].Score = score
+				}
+				e.Score = idx.fuse(e.Sources)
+				return
+			}
+		}
+		e.Sources = append(e.Sources, SourceScore{Source: source, Score: score})
+		e.Score = idx.fuse(e.Sources)
+		return
+	}
+
+	sources := []SourceScore{{Source: source, Score: score}}
+	idx.data[kanji] = append(idx.data[kanji], Entry{Kan: similarKanji, Score: idx.fuse(sources), Sources: sources})
+}
+
+// AddScoredFile adds every pair in the file with a score above threshold.
+// The file is validated first, see Strict.
+func (idx *Index) AddScoredFile(source, filename string, threshold float32) error {
+	data, err := idx.loadSourceFile(filename, true, 0)
+	if err != nil {
+		return err
+	}
+	for _, k := range data {
+		for _, entry := range k.entries {
+			if entry.Score > threshold {
+				idx.Add(source, k.kanji, entry.Kan, entry.Score)
+			}
+		}
+	}
+	return nil
+}
+
+// AddScored adds every pair with a score above threshold.
+func (idx *Index) AddScored(source string, data map[string]EntryList, threshold float32) {
+	for kanji, entries := range data {
+		for _, entry := range entries {
+			if entry.Score > threshold {
+				idx.Add(source, kanji, entry.Kan, entry.Score)
+			}
+		}
+	}
+}
+
+// AddUnscoredFile adds every pair in the file with the same score.  The file
+// is validated first, see Strict.
+func (idx *Index) AddUnscoredFile(source, filename string, score float32) error {
+	data, err := idx.loadSourceFile(filename, false, score)
+	if err != nil {
+		return err
+	}
+	for _, k := range data {
+		for _, entry := range k.entries {
+			idx.Add(source, k.kanji, entry.Kan, entry.Score)
+		}
+	}
+	return nil
+}
+
+// Sort fuses the scores of every entry and orders each kanji's similar kanji
+// by score.  Entries with the same score are ordered by the priority of their
+// sources, then by codepoint, so the same inputs always give the same order.
+//
+// A source's priority is the order it was first added to the index, so the
+// sources earlier in a manifest win ties.  An entry from several sources uses
+// the earliest of them.
+func (idx *Index) Sort() {
+	idx.Fuse()
+
+	priority := map[string]int{}
+	for i, name := range idx.sources {
+		priority[name] = i
+	}
+	entryPriority := func(e *Entry) int {
+		ret := len(idx.sources)
+		for _, s := range e.Sources {
+			if p, ok := priority[s.Source]; ok && p < ret {
+				ret = p
+			}
+		}
+		return ret
+	}
+
+	for kanji, entries := range idx.data {
+		sort.Slice(entries, func(i, j int) bool {
+			a, b := &entries[i], &entries[j]
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+			if pa, pb := entryPriority(a), entryPriority(b); pa != pb {
+				return pa < pb
+			}
+			return a.Kan < b.Kan
+		})
+		idx.data[kanji] = movePinned(entries)
+	}
+}
+
+// movePinned moves entries with a Position there, keeping the others in order.
+func movePinned(entries EntryList) EntryList {
+	var pinned, rest EntryList
+	for _, e := range entries {
+		if e.Position > 0 {
+			pinned = append(pinned, e)
+		} else {
+			rest = append(rest, e)
+		}
+	}
+	if len(pinned) == 0 {
+		return entries
+	}
+	sort.SliceStable(pinned, func(i, j int) bool { return pinned[i].Position < pinned[j].Position })
+
+	ret := make(EntryList, 0, len(entries))
+	for _, e := range pinned {
+		for len(ret) < e.Position-1 && len(rest) != 0 {
+			ret = append(ret, rest[0])
+			rest = rest[1:]
+		}
+		ret = append(ret, e)
+	}
+	return append(ret, rest...)
+}
+
+// Compact returns the index as a map from each kanji to a string containing
+// its similar kanji in order.
+func (idx *Index) Compact() map[string]string {
+	compact := map[string]string{}
+	for k, v := range idx.data {
+		var str string
+		for _, entry := range v {
+			str += entry.Kan
+		}
+		compact[k] = str
+	}
+	return compact
+}
+
+// Scored returns the index with the score and sources of every entry.  The
+// returned map is owned by the index.
+func (idx *Index) Scored() map[string]EntryList {
+	return idx.data
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+type testPair struct {
+	kanji, similar string
+	score          float32
+}
+
+// testSources has many entries with the same score, so their order comes
+// only from the tie-break.
+var testSources = []struct {
+	name  string
+	pairs []testPair
+}{
+	{"manual", []testPair{
+		{"未", "末", 1}, {"未", "本", 1}, {"未", "朱", 1}, {"末", "未", 1}, {"本", "体", 1},
+	}},
+	{"scored", []testPair{
+		{"未", "味", 0.5}, {"未", "妹", 0.5}, {"未", "体", 1}, {"未", "末", 0.9},
+		{"末", "本", 0.5}, {"末", "朱", 0.5}, {"本", "木", 1}, {"本", "休", 1},
+	}},
+	{"unscored", []testPair{
+		{"未", "木", 1}, {"未", "味", 1}, {"末", "木", 1}, {"本", "末", 1}, {"本", "未", 1},
+	}},
+}
+
+// buildIndex adds the pairs of each source in an order chosen by seed.
+func buildIndex(seed int64) *Index {
+	r := rand.New(rand.NewSource(seed))
+	idx := Create()
+	for _, src := range testSources {
+		pairs := append([]testPair(nil), src.pairs...)
+		r.Shuffle(len(pairs), func(i, j int) { pairs[i], pairs[j] = pairs[j], pairs[i] })
+		for _, p := range pairs {
+			idx.Add(src.name, p.kanji, p.similar, p.score)
+		}
+	}
+	idx.Sort()
+	return idx
+}
+
+func TestSortOrder(t *testing.T) {
+	want := map[string]string{
+		"未": "末本朱体味木妹",
+		"末": "未木本朱",
+		"本": "体休木未末",
+	}
+	if got := buildIndex(1).Compact(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSortIsDeterministic(t *testing.T) {
+	output := func(idx *Index) []byte {
+		compact, err := json.Marshal(idx.Compact())
+		if err != nil {
+			t.Fatal(err)
+		}
+		scored, err := json.MarshalIndent(idx.Scored(), "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(compact, scored...)
+	}
+
+	want := output(buildIndex(0))
+	for seed := int64(1); seed < 20; seed++ {
+		if got := output(buildIndex(seed)); !bytes.Equal(got, want) {
+			t.Fatalf("seed %d gave different output:\n%s\nwant:\n%s", seed, got, want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Limit keeps only the first k similar kanji of each kanji.  The index should
+// be sorted first.  Returns the number of entries that were removed.
+func (idx *Index) Limit(k int) int {
+	removed := 0
+	for kanji, entries := range idx.data {
+		if len(entries) > k {
+			removed += len(entries) - k
+			idx.data[kanji] = entries[:k]
+		}
+	}
+	return removed
+}
+
+// Histogram counts the kanji that have each number of similar kanji.
+type Histogram map[int]int
+
+func (idx *Index) Histogram() Histogram {
+	h := Histogram{}
+	for _, entries := range idx.data {
+		h[len(entries)]++
+	}
+	return h
+}
+
+func (h Histogram) lengths() []int {
+	var ret []int
+	for length := range h {
+		ret = append(ret, length)
+	}
+	sort.Ints(ret)
+	return ret
+}
+
+// WriteHistograms prints histograms side by side, one row per list length
+// that appears in any of them, with a bar for the last one.
+func WriteHistograms(w io.Writer, names []string, hs ...Histogram) error {
+	seen := map[int]bool{}
+	var lengths []int
+	for _, h := range hs {
+		for _, length := range h.lengths() {
+			if !seen[length] {
+				seen[length] = true
+				lengths = append(lengths, length)
+			}
+		}
+	}
+	sort.Ints(lengths)
+
+	maxCount := 0
+	for _, count := range hs[len(hs)-1] {
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "%6s", "length"); err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Fprintf(w, " %8s", name)
+	}
+	fmt.Fprintln(w)
+
+	for _, length := range lengths {
+		fmt.Fprintf(w, "%6d", length)
+		for _, h := range hs {
+			fmt.Fprintf(w, " %8d", h[length])
+		}
+		if count := hs[len(hs)-1][length]; count > 0 {
+			fmt.Fprintf(w, " %s", strings.Repeat("#", (count*40+maxCount-1)/maxCount))
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
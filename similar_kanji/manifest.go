@@ -0,0 +1,201 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unicode"
+
+	"github.com/davidsansome/tsurukame/similar_kanji/ids"
+)
+
+// SourceIDS sources are scored from an Ideographic Description Sequence file
+// by the components each kanji shares with others.
+const SourceIDS = "ids"
+
+// Source is one dataset of similar kanji.
+type Source struct {
+	// Name identifies the source in provenance and weights.  Defaults to the
+	// base name of File.
+	Name string `json:"name"`
+
+	// File is the path to the JSON file.  Relative paths in a manifest are
+	// resolved against the directory containing the manifest.
+	File string `json:"file"`
+
+	// Kind is empty for JSON files, or SourceIDS.
+	Kind string `json:"kind"`
+
+	// Scored files map each kanji to a list of {"kan", "score"} objects.
+	// Unscored files map each kanji to a list of similar kanji.
+	Scored bool `json:"scored"`
+
+	// Optional sources are skipped with a warning if the file doesn't exist.
+	Optional bool `json:"optional"`
+
+	// Weight multiplies this source's scores before they are fused with other
+	// sources.  Defaults to 1.
+	Weight *float32 `json:"weight"`
+
+	// Score is given to every pair from an unscored file.  Defaults to 1.
+	Score *float32 `json:"score"`
+
+	// Threshold is the minimum score of pairs from a scored or IDS source.
+	// Defaults to the index's threshold.
+	Threshold *float32 `json:"threshold"`
+
+	// Only is a text file of the kanji an IDS source finds similar kanji for.
+	// Defaults to every character in the CJK Unified Ideographs block.
+	Only string `json:"only"`
+}
+
+// SourceName returns the name the source is recorded under in the index.
+func (s *Source) SourceName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return filepath.Base(s.File)
+}
+
+// Manifest describes which sources to merge and where to write the result.
+type Manifest struct {
+	Threshold *float32      `json:"threshold"`
+	Fusion    Fusion        `json:"fusion"`
+	Limit     int           `json:"limit"`
+	Strict    bool          `json:"strict"`
+	Overrides string        `json:"overrides"`
+	Closure   ClosurePolicy `json:"closure"`
+	Output    string        `json:"output"`
+	Sources   []Source      `json:"sources"`
+}
+
+func LoadManifest(filename string) (*Manifest, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	if m.Fusion != "" {
+		if _, err := ParseFusion(string(m.Fusion)); err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+
+	if m.Limit < 0 {
+		return nil, fmt.Errorf("%s: limit must not be negative", filename)
+	}
+	if err := m.Closure.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	dir := filepath.Dir(filename)
+	for i, src := range m.Sources {
+		if src.File == "" {
+			return nil, fmt.Errorf("%s: source %d has no file", filename, i)
+		}
+		switch src.Kind {
+		case "", SourceIDS:
+		default:
+			return nil, fmt.Errorf("%s: source %d has unknown kind %q", filename, i, src.Kind)
+		}
+		if !filepath.IsAbs(src.File) {
+			m.Sources[i].File = filepath.Join(dir, src.File)
+		}
+		if src.Only != "" && !filepath.IsAbs(src.Only) {
+			m.Sources[i].Only = filepath.Join(dir, src.Only)
+		}
+	}
+	if m.Overrides != "" && !filepath.IsAbs(m.Overrides) {
+		m.Overrides = filepath.Join(dir, m.Overrides)
+	}
+	if m.Output != "" && !filepath.IsAbs(m.Output) {
+		m.Output = filepath.Join(dir, m.Output)
+	}
+	return &m, nil
+}
+
+// AddSource adds the contents of the source's file to the index, and sets
+// the source's weight.
+func (idx *Index) AddSource(src Source) error {
+	name := src.SourceName()
+	if src.Weight != nil {
+		idx.Weights[name] = *src.Weight
+	}
+	threshold := idx.Threshold
+	if src.Threshold != nil {
+		threshold = *src.Threshold
+	}
+	if src.Kind == SourceIDS {
+		return idx.addIDSSource(name, src, threshold)
+	}
+	if src.Scored {
+		return idx.AddScoredFile(name, src.File, threshold)
+	}
+	score := float32(1.0)
+	if src.Score != nil {
+		score = *src.Score
+	}
+	return idx.AddUnscoredFile(name, src.File, score)
+}
+
+// ReadKanjiList reads a text file of kanji, ignoring whitespace.
+func ReadKanjiList(filename string) (map[string]bool, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]bool{}
+	for _, r := range string(b) {
+		if !unicode.IsSpace(r) {
+			ret[string(r)] = true
+		}
+	}
+	return ret, nil
+}
+
+func (idx *Index) addIDSSource(name string, src Source, threshold float32) error {
+	opts := ids.Options{Threshold: float64(threshold)}
+	if src.Only != "" {
+		var err error
+		if opts.Only, err = ReadKanjiList(src.Only); err != nil {
+			return err
+		}
+	}
+
+	t, errs := ids.ParseFile(src.File)
+	if t == nil {
+		return errs[0]
+	}
+	idx.Warnings = append(idx.Warnings, errs...)
+	if len(t) == 0 {
+		return fmt.Errorf("%s: no sequences found", src.File)
+	}
+
+	data := map[string]EntryList{}
+	for kanji, matches := range t.ScoreAll(opts) {
+		for _, m := range matches {
+			data[kanji] = append(data[kanji], Entry{Kan: m.Char, Score: float32(m.Score)})
+		}
+	}
+	idx.AddScored(name, data, threshold)
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Override changes one pair of kanji after every source has been merged.
+type Override struct {
+	Kanji   string `json:"kanji"`
+	Similar string `json:"similar"`
+
+	// Score, if set, replaces the fused score of the pair.
+	Score *float32 `json:"score,omitempty"`
+
+	// Position, if set, moves the pair to this position in the kanji's list,
+	// starting at 1.
+	Position int `json:"position,omitempty"`
+
+	// Note explains why the override exists.  Notes on pins are kept in the
+	// scored output.
+	Note string `json:"note,omitempty"`
+}
+
+func (o *Override) String() string {
+	return fmt.Sprintf("%s→%s", o.Kanji, o.Similar)
+}
+
+// Overrides is the contents of an overrides file.
+type Overrides struct {
+	// Deny removes pairs in both directions, whichever sources listed them
+	// and whether or not they were added by closure.
+	Deny []Override `json:"deny"`
+
+	// Pin fixes the score or position of pairs.
+	Pin []Override `json:"pin"`
+}
+
+func LoadOverrides(filename string) (*Overrides, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var o Overrides
+	if err := json.Unmarshal(b, &o); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	for _, list := range [][]Override{o.Deny, o.Pin} {
+		for _, p := range list {
+			if !isKanji(p.Kanji) || !isKanji(p.Similar) {
+				return nil, fmt.Errorf("%s: %s: kanji must be single CJK characters", filename, &p)
+			}
+			if p.Kanji == p.Similar {
+				return nil, fmt.Errorf("%s: %s: a kanji can't be similar to itself", filename, &p)
+			}
+		}
+	}
+	for _, p := range o.Pin {
+		if p.Score == nil && p.Position == 0 {
+			return nil, fmt.Errorf("%s: pin %s has neither a score nor a position", filename, &p)
+		}
+		if p.Score != nil && (*p.Score < 0 || *p.Score > 1) {
+			return nil, fmt.Errorf("%s: pin %s has score %v outside [0, 1]", filename, &p, *p.Score)
+		}
+		if p.Position < 0 {
+			return nil, fmt.Errorf("%s: pin %s has a negative position", filename, &p)
+		}
+	}
+	return &o, nil
+}
+
+// CheckLimit returns an error if a pin moves a pair past the end of lists
+// limited to k entries, where the limit would silently cut it.
+func (o *Overrides) CheckLimit(k int) error {
+	for _, p := range o.Pin {
+		if p.Position > k {
+			return fmt.Errorf("pin %s has position %d, past the limit of %d", &p, p.Position, k)
+		}
+	}
+	return nil
+}
+
+// OverrideResult records whether an override changed anything.  Stale
+// overrides are for pairs that aren't in the index, and can probably be
+// deleted.
+type OverrideResult struct {
+	Kind     string `json:"kind"`
+	Override `json:"override"`
+	Used     bool `json:"used"`
+}
+
+func (idx *Index) findEntry(kanji, similar string) int {
+	for i, e := range idx.data[kanji] {
+		if e.Kan == similar {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeEntry removes similar from kanji's list and returns whether it was
+// there.
+func (idx *Index) removeEntry(kanji, similar string) bool {
+	i := idx.findEntry(kanji, similar)
+	if i == -1 {
+		return false
+	}
+	entries := idx.data[kanji]
+	idx.data[kanji] = append(entries[:i:i], entries[i+1:]...)
+	if len(idx.data[kanji]) == 0 {
+		delete(idx.data, kanji)
+	}
+	return true
+}
+
+// ApplyOverrides removes the denied pairs, pins the others and sorts the index.
+// A denied pair is removed in both directions, so it can't come back as the
+// reverse edge a symmetric closure added.  Pins only apply to pairs that are
+// already in the index.
+func (idx *Index) ApplyOverrides(o *Overrides) []OverrideResult {
+	var ret []OverrideResult
+	for _, d := range o.Deny {
+		forward := idx.removeEntry(d.Kanji, d.Similar)
+		reverse := idx.removeEntry(d.Similar, d.Kanji)
+		ret = append(ret, OverrideResult{"deny", d, forward || reverse})
+	}
+
+	for _, p := range o.Pin {
+		i := idx.findEntry(p.Kanji, p.Similar)
+		if i != -1 {
+			e := &idx.data[p.Kanji][i]
+			if p.Score != nil {
+				e.Score = *p.Score
+				e.Pinned = true
+			}
+			e.Position = p.Position
+			e.Note = p.Note
+		}
+		ret = append(ret, OverrideResult{"pin", p, i != -1})
+	}
+
+	idx.Sort()
+	return ret
+}
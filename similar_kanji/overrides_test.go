@@ -0,0 +1,133 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func float32p(f float32) *float32 { return &f }
+
+func TestDenyRemovesBothDirections(t *testing.T) {
+	idx := Create()
+	idx.Add("a", "未", "末", 0.9)
+	idx.Add("a", "未", "本", 0.8)
+	if _, err := idx.Close(ClosurePolicy{Symmetric: true}); err != nil {
+		t.Fatal(err)
+	}
+	idx.Sort()
+	if got := idx.Compact()["末"]; got != "未" {
+		t.Fatalf("closure didn't add 末→未, got %q", got)
+	}
+
+	results := idx.ApplyOverrides(&Overrides{Deny: []Override{{Kanji: "未", Similar: "末"}}})
+	want := map[string]string{"未": "本", "本": "未"}
+	if got := idx.Compact(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if len(results) != 1 || !results[0].Used {
+		t.Errorf("deny not reported as used: %+v", results)
+	}
+}
+
+func TestDenyReverseOnly(t *testing.T) {
+	// Denying A→B also removes B→A when only B→A is in the index.
+	idx := Create()
+	idx.Add("a", "末", "未", 0.9)
+	results := idx.ApplyOverrides(&Overrides{Deny: []Override{{Kanji: "未", Similar: "末"}}})
+	if got := idx.Compact(); len(got) != 0 {
+		t.Errorf("got %v, want an empty index", got)
+	}
+	if !results[0].Used {
+		t.Errorf("deny not reported as used")
+	}
+}
+
+func TestDenyStale(t *testing.T) {
+	idx := Create()
+	idx.Add("a", "未", "本", 0.8)
+	results := idx.ApplyOverrides(&Overrides{Deny: []Override{{Kanji: "未", Similar: "末"}}})
+	if results[0].Used {
+		t.Errorf("deny of a missing pair reported as used")
+	}
+	if got := idx.Compact()["未"]; got != "本" {
+		t.Errorf("got %q, want 本", got)
+	}
+}
+
+func TestPin(t *testing.T) {
+	idx := Create()
+	idx.Add("a", "未", "末", 0.9)
+	idx.Add("a", "未", "本", 0.8)
+	idx.Add("a", "未", "木", 0.7)
+	results := idx.ApplyOverrides(&Overrides{Pin: []Override{
+		{Kanji: "未", Similar: "木", Position: 1},
+		{Kanji: "未", Similar: "本", Score: float32p(0.95)},
+		{Kanji: "未", Similar: "朱", Position: 2},
+	}})
+	if got := idx.Compact()["未"]; got != "木本末" {
+		t.Errorf("got %q, want 木本末", got)
+	}
+	var used []bool
+	for _, r := range results {
+		used = append(used, r.Used)
+	}
+	if want := []bool{true, true, false}; !reflect.DeepEqual(used, want) {
+		t.Errorf("used = %v, want %v", used, want)
+	}
+	if e := idx.Scored()["未"][1]; !e.Pinned || e.Score != 0.95 {
+		t.Errorf("pinned score not kept: %+v", e)
+	}
+}
+
+func TestCheckLimit(t *testing.T) {
+	o := &Overrides{Pin: []Override{{Kanji: "未", Similar: "末", Position: 3}}}
+	if err := o.CheckLimit(3); err != nil {
+		t.Errorf("CheckLimit(3) = %v", err)
+	}
+	if err := o.CheckLimit(2); err == nil {
+		t.Errorf("CheckLimit(2) didn't fail")
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	for _, tc := range []struct {
+		name, json string
+		ok         bool
+	}{
+		{"valid", `{"deny": [{"kanji": "未", "similar": "末"}], "pin": [{"kanji": "土", "similar": "士", "score": 0.9}]}`, true},
+		{"not kanji", `{"deny": [{"kanji": "a", "similar": "末"}]}`, false},
+		{"self", `{"deny": [{"kanji": "未", "similar": "未"}]}`, false},
+		{"empty pin", `{"pin": [{"kanji": "未", "similar": "末"}]}`, false},
+		{"score out of range", `{"pin": [{"kanji": "未", "similar": "末", "score": 1.5}]}`, false},
+		{"negative position", `{"pin": [{"kanji": "未", "similar": "末", "position": -1}]}`, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "overrides.json")
+			if err := os.WriteFile(path, []byte(tc.json), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadOverrides(path)
+			if tc.ok && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if !tc.ok && err == nil {
+				t.Errorf("no error")
+			}
+		})
+	}
+}
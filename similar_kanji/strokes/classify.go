@@ -0,0 +1,283 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strokes
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Class is a broad category of stroke.
+type Class int
+
+const (
+	Unknown Class = iota
+	Horizontal
+	Vertical
+	LeftFalling
+	RightFalling
+	Dot
+	Rising
+	Hook
+	Turn
+)
+
+var classNames = map[Class]string{
+	Unknown:      "unknown",
+	Horizontal:   "horizontal",
+	Vertical:     "vertical",
+	LeftFalling:  "left-falling",
+	RightFalling: "right-falling",
+	Dot:          "dot",
+	Rising:       "rising",
+	Hook:         "hook",
+	Turn:         "turn",
+}
+
+func (c Class) String() string {
+	return classNames[c]
+}
+
+// Classes of the CJK Strokes block, U+31C0 to U+31E3, which KanjiVG uses for
+// its stroke types.
+var strokeClasses = map[rune]Class{
+	'㇀': Rising,
+	'㇁': Hook,
+	'㇂': Hook,
+	'㇃': Hook,
+	'㇄': Turn,
+	'㇅': Turn,
+	'㇆': Turn,
+	'㇇': Turn,
+	'㇈': Turn,
+	'㇉': Turn,
+	'㇊': Turn,
+	'㇋': Turn,
+	'㇌': Turn,
+	'㇍': Turn,
+	'㇎': Turn,
+	'㇏': RightFalling,
+	'㇐': Horizontal,
+	'㇑': Vertical,
+	'㇒': LeftFalling,
+	'㇓': LeftFalling,
+	'㇔': Dot,
+	'㇕': Turn,
+	'㇖': Hook,
+	'㇗': Turn,
+	'㇘': Turn,
+	'㇙': Turn,
+	'㇚': Hook,
+	'㇛': Turn,
+	'㇜': Turn,
+	'㇝': RightFalling,
+	'㇞': Turn,
+	'㇟': Hook,
+	'㇠': Hook,
+	'㇡': Hook,
+	'㇢': Hook,
+	'㇣': Turn,
+}
+
+// ClassifyType returns the class of a KanjiVG stroke type.  Types can have a
+// suffix ("㇐a") or list alternatives ("㇔/㇀"), in which case the first stroke
+// is used.
+func ClassifyType(typ string) Class {
+	r, _ := utf8.DecodeRuneInString(typ)
+	return strokeClasses[r]
+}
+
+// Strokes shorter than this, in KanjiVG's 109x109 coordinate space, are dots.
+const dotLength = 12
+
+// ClassifyPath guesses the class of a stroke from its SVG path, for strokes
+// that have no type.  Only the direction from the start to the end of the path
+// is used, so hooks and turns are classified by their overall direction.
+func ClassifyPath(d string) (Class, error) {
+	start, end, err := pathEndpoints(d)
+	if err != nil {
+		return Unknown, err
+	}
+	dx, dy := end.x-start.x, end.y-start.y
+
+	switch {
+	case math.Hypot(dx, dy) < dotLength:
+		return Dot, nil
+	case math.Abs(dx) > 2*math.Abs(dy):
+		if dx > 0 {
+			return Horizontal, nil
+		}
+		return LeftFalling, nil
+	case math.Abs(dy) > 2*math.Abs(dx):
+		return Vertical, nil
+	case dx < 0:
+		return LeftFalling, nil
+	case dy < 0:
+		return Rising, nil
+	default:
+		return RightFalling, nil
+	}
+}
+
+type point struct {
+	x, y float64
+}
+
+// Number of arguments taken by each SVG path command.
+var pathArgs = map[byte]int{
+	'M': 2, 'L': 2, 'T': 2,
+	'H': 1, 'V': 1,
+	'C': 6, 'S': 4, 'Q': 4,
+	'A': 7,
+	'Z': 0,
+}
+
+// pathEndpoints returns the first and last points of an SVG path.
+func pathEndpoints(d string) (start, end point, err error) {
+	var cmd byte
+	var args []float64
+	started := false
+	cur := point{}
+	subpath := point{}
+
+	apply := func() error {
+		upper := cmd &^ 0x20
+		n, ok := pathArgs[upper]
+		if !ok {
+			return fmt.Errorf("unknown path command %q", cmd)
+		}
+		relative := cmd != upper
+		if n == 0 {
+			cur = subpath
+			return nil
+		}
+		if len(args) != n {
+			return nil
+		}
+
+		var next point
+		switch upper {
+		case 'H':
+			next = point{args[0], cur.y}
+			if relative {
+				next.x += cur.x
+			}
+		case 'V':
+			next = point{cur.x, args[0]}
+			if relative {
+				next.y += cur.y
+			}
+		default:
+			next = point{args[n-2], args[n-1]}
+			if relative {
+				next.x += cur.x
+				next.y += cur.y
+			}
+		}
+		cur = next
+		args = args[:0]
+
+		if upper == 'M' {
+			subpath = cur
+			if !started {
+				start = cur
+				started = true
+			}
+			// Further coordinates after a move are lines.
+			cmd = 'L' | (cmd & 0x20)
+		}
+		return nil
+	}
+
+	for i := 0; i < len(d); {
+		c := d[i]
+		switch {
+		case c == ' ' || c == ',' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			if len(args) != 0 {
+				return start, end, fmt.Errorf("path command %q has too few arguments", cmd)
+			}
+			cmd = c
+			i++
+			if err := apply(); err != nil {
+				return start, end, err
+			}
+		default:
+			j := scanNumber(d, i)
+			if j == i {
+				return start, end, fmt.Errorf("unexpected %q in path", c)
+			}
+			v, err := strconv.ParseFloat(d[i:j], 64)
+			if err != nil {
+				return start, end, err
+			}
+			if cmd == 0 {
+				return start, end, fmt.Errorf("path doesn't start with a command")
+			}
+			args = append(args, v)
+			i = j
+			if err := apply(); err != nil {
+				return start, end, err
+			}
+		}
+	}
+	if len(args) != 0 {
+		return start, end, fmt.Errorf("path command %q has too few arguments", cmd)
+	}
+	if !started {
+		return start, end, fmt.Errorf("empty path")
+	}
+	return start, cur, nil
+}
+
+// scanNumber returns the end of the number starting at d[i].  SVG paths
+// allow numbers to follow each other without a separator, as in "1-2" or
+// "0.5.5".
+func scanNumber(d string, i int) int {
+	j := i
+	if j < len(d) && (d[j] == '-' || d[j] == '+') {
+		j++
+	}
+	seenDot := false
+	digits := 0
+	for ; j < len(d); j++ {
+		if d[j] >= '0' && d[j] <= '9' {
+			digits++
+		} else if d[j] == '.' && !seenDot {
+			seenDot = true
+		} else {
+			break
+		}
+	}
+	if digits == 0 {
+		return i
+	}
+	if j < len(d) && (d[j] == 'e' || d[j] == 'E') {
+		k := j + 1
+		if k < len(d) && (d[k] == '-' || d[k] == '+') {
+			k++
+		}
+		if k < len(d) && d[k] >= '0' && d[k] <= '9' {
+			for k < len(d) && d[k] >= '0' && d[k] <= '9' {
+				k++
+			}
+			j = k
+		}
+	}
+	return j
+}
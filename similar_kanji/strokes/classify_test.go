@@ -0,0 +1,127 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strokes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPathEndpoints(t *testing.T) {
+	for _, tc := range []struct {
+		d          string
+		start, end point
+	}{
+		{"M10,20L30,40", point{10, 20}, point{30, 40}},
+		{"m10,20l5,5", point{10, 20}, point{15, 25}},
+		{"M10 20 30 40 50 60", point{10, 20}, point{50, 60}},
+		{"m10,20 5,5 5,5", point{10, 20}, point{20, 30}},
+		{"M10,20H50", point{10, 20}, point{50, 20}},
+		{"M10,20h5v5", point{10, 20}, point{15, 25}},
+		{"M10,20V5", point{10, 20}, point{10, 5}},
+		{"M10,20C1,2,3,4,5,6", point{10, 20}, point{5, 6}},
+		{"M10,20c1,2,3,4,5,6 1,2,3,4,5,6", point{10, 20}, point{20, 32}},
+		{"M10,20s1,1,2,2q1,1,2,2t1,1", point{10, 20}, point{15, 25}},
+		{"M10,20a5,5,0,0,1,10,0", point{10, 20}, point{20, 20}},
+		{"M10-20-5.5.5", point{10, -20}, point{-5.5, 0.5}},
+		{"M1e1,2E0L3,4", point{10, 2}, point{3, 4}},
+		{"M10,20L30,40Z", point{10, 20}, point{10, 20}},
+		{"M10,20L30,40M50,60L70,80", point{10, 20}, point{70, 80}},
+
+		// 一 in KanjiVG: relative curves with implicit repeats.
+		{"M11,54.25c3.19,0.62,6.25,0.75,9.73,0.5c20.64-1.5,50.39-5.12,68.58-5.24c3.6-0.02,5.77,0.24,7.57,0.49", point{11, 54.25}, point{96.88, 50}},
+
+		// A stroke like the first of 人, mixing relative and absolute curves.
+		{"M54.5,20.5c0.25,1.5,0.41,3.67-0.24,5.88C49.5,41.75,37.25,63.75,15.5,80.5", point{54.5, 20.5}, point{15.5, 80.5}},
+	} {
+		start, end, err := pathEndpoints(tc.d)
+		if err != nil {
+			t.Errorf("%q: %v", tc.d, err)
+			continue
+		}
+		if !near(start, tc.start) || !near(end, tc.end) {
+			t.Errorf("%q: got %v to %v, want %v to %v", tc.d, start, end, tc.start, tc.end)
+		}
+	}
+}
+
+func near(a, b point) bool {
+	return math.Abs(a.x-b.x) < 1e-9 && math.Abs(a.y-b.y) < 1e-9
+}
+
+func TestPathEndpointsErrors(t *testing.T) {
+	for _, d := range []string{
+		"",
+		"10,20",
+		"L10,20",
+		"M10",
+		"M10,20L5",
+		"M10,20X5,5",
+		"M10,20L#",
+		"M10,20c1,2,3,4,5,6 1,2",
+	} {
+		if _, _, err := pathEndpoints(d); err == nil {
+			t.Errorf("%q: no error", d)
+		}
+	}
+}
+
+func TestClassifyPath(t *testing.T) {
+	for _, tc := range []struct {
+		d    string
+		want Class
+	}{
+		{"M10,50L90,50", Horizontal},
+		{"M90,50L10,50", LeftFalling},
+		{"M50,10L50,90", Vertical},
+		{"M50,90L50,10", Vertical},
+		{"M60,20L20,80", LeftFalling},
+		{"M20,20L80,80", RightFalling},
+		{"M20,80L80,20", Rising},
+		{"M50,50l3,4", Dot},
+		{"M11,54.25c3.19,0.62,6.25,0.75,9.73,0.5c20.64-1.5,50.39-5.12,68.58-5.24c3.6-0.02,5.77,0.24,7.57,0.49", Horizontal},
+		{"M54.5,20.5c0.25,1.5,0.41,3.67-0.24,5.88C49.5,41.75,37.25,63.75,15.5,80.5", LeftFalling},
+	} {
+		got, err := ClassifyPath(tc.d)
+		if err != nil {
+			t.Errorf("%q: %v", tc.d, err)
+		} else if got != tc.want {
+			t.Errorf("%q: got %v, want %v", tc.d, got, tc.want)
+		}
+	}
+}
+
+func TestClassifyType(t *testing.T) {
+	for _, tc := range []struct {
+		typ  string
+		want Class
+	}{
+		{"㇐", Horizontal},
+		{"㇐a", Horizontal},
+		{"㇑", Vertical},
+		{"㇒", LeftFalling},
+		{"㇏", RightFalling},
+		{"㇔/㇀", Dot},
+		{"㇀/㇔", Rising},
+		{"㇚", Hook},
+		{"㇕", Turn},
+		{"", Unknown},
+		{"x", Unknown},
+	} {
+		if got := ClassifyType(tc.typ); got != tc.want {
+			t.Errorf("ClassifyType(%q) = %v, want %v", tc.typ, got, tc.want)
+		}
+	}
+}
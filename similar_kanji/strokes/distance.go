@@ -0,0 +1,158 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strokes
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/davidsansome/tsurukame/similar_kanji"
+)
+
+// substitutionCost is the cost of replacing stroke a with stroke b.  Strokes of
+// the same type are free, and strokes of the same class cost half as much as
+// strokes of different classes.
+func substitutionCost(a, b Stroke) float64 {
+	switch {
+	case a.Type != "" && a.Type == b.Type:
+		return 0
+	case a.Class != b.Class:
+		return 1
+	case a.Type == "" && b.Type == "":
+		return 0
+	default:
+		return 0.5
+	}
+}
+
+// Distance is the edit distance between two stroke sequences, where inserting
+// or deleting a stroke costs 1 and substitutions cost substitutionCost.
+func Distance(a, b []Stroke) float64 {
+	prev := make([]float64, len(b)+1)
+	cur := make([]float64, len(b)+1)
+	for j := range prev {
+		prev[j] = float64(j)
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = float64(i)
+		for j := 1; j <= len(b); j++ {
+			cur[j] = math.Min(
+				math.Min(prev[j]+1, cur[j-1]+1),
+				prev[j-1]+substitutionCost(a[i-1], b[j-1]))
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// Similarity is the edit distance normalized to [0, 1], where 1 means the
+// stroke sequences are identical.
+func Similarity(a, b *Kanji) float64 {
+	longest := len(a.Strokes)
+	if len(b.Strokes) > longest {
+		longest = len(b.Strokes)
+	}
+	if longest == 0 {
+		return 0
+	}
+	return 1 - Distance(a.Strokes, b.Strokes)/float64(longest)
+}
+
+// Options controls which pairs ScoreAll returns.
+type Options struct {
+	// Pairs with a similarity at or below Threshold are dropped.
+	Threshold float64
+
+	// Limit is the maximum number of similar kanji kept for each kanji, or 0
+	// for no limit.
+	Limit int
+
+	// Only, if not empty, restricts the kanji that get similar kanji.  Similar
+	// kanji can still come from any loaded kanji.
+	Only map[string]bool
+}
+
+// ScoreAll compares every pair of kanji and returns the similar kanji of each
+// one, in the scored format read by similar_kanji.Index.AddScoredFile.
+func ScoreAll(kanji map[string]*Kanji, opts Options) map[string]similar_kanji.EntryList {
+	var chars []string
+	for c := range kanji {
+		chars = append(chars, c)
+	}
+	sort.Strings(chars)
+
+	work := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ret := map[string]similar_kanji.EntryList{}
+
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range work {
+				entries := scoreOne(kanji[c], chars, kanji, opts)
+				if len(entries) == 0 {
+					continue
+				}
+				mu.Lock()
+				ret[c] = entries
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, c := range chars {
+		if len(opts.Only) == 0 || opts.Only[c] {
+			work <- c
+		}
+	}
+	close(work)
+	wg.Wait()
+	return ret
+}
+
+func scoreOne(k *Kanji, chars []string, kanji map[string]*Kanji, opts Options) similar_kanji.EntryList {
+	var ret similar_kanji.EntryList
+	for _, c := range chars {
+		if c == k.Char {
+			continue
+		}
+		other := kanji[c]
+
+		// The edit distance is at least the difference in length, so skip
+		// pairs that can't get above the threshold.
+		longest := len(k.Strokes)
+		diff := len(k.Strokes) - len(other.Strokes)
+		if diff < 0 {
+			diff = -diff
+			longest = len(other.Strokes)
+		}
+		if 1-float64(diff)/float64(longest) <= opts.Threshold {
+			continue
+		}
+
+		if score := Similarity(k, other); score > opts.Threshold {
+			ret = append(ret, similar_kanji.Entry{Kan: c, Score: float32(score)})
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].Score > ret[j].Score })
+	if opts.Limit > 0 && len(ret) > opts.Limit {
+		ret = ret[:opts.Limit]
+	}
+	return ret
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package strokes computes the similarity of kanji from the sequence of their
+// strokes, using stroke data in the KanjiVG SVG format.
+package strokes
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Kanji is the stroke sequence of one character.
+type Kanji struct {
+	Char    string
+	Strokes []Stroke
+}
+
+// Stroke is one stroke of a kanji.
+type Stroke struct {
+	// Type is the stroke type from KanjiVG's kvg:type attribute, eg. "㇐".
+	// Empty if the file didn't have one.
+	Type string
+
+	// Class is the broad category of the stroke, from its Type or, if that is
+	// missing, from the shape of its path.
+	Class Class
+}
+
+// charFromFilename returns the character in a KanjiVG file called, for
+// example, "04e00.svg".  Variant files like "04e00-Kaisho.svg" are not
+// recognised.
+func charFromFilename(filename string) (string, bool) {
+	base := strings.TrimSuffix(filepath.Base(filename), ".svg")
+	if strings.Contains(base, "-") {
+		return "", false
+	}
+	cp, err := strconv.ParseUint(base, 16, 32)
+	if err != nil {
+		return "", false
+	}
+	return string(rune(cp)), true
+}
+
+// Parse reads the strokes from a KanjiVG SVG document.
+func Parse(r io.Reader, char string) (*Kanji, error) {
+	k := &Kanji{Char: char}
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		el, ok := tok.(xml.StartElement)
+		if !ok || el.Name.Local != "path" {
+			continue
+		}
+
+		var id, typ, path string
+		for _, attr := range el.Attr {
+			switch attr.Name.Local {
+			case "id":
+				id = attr.Value
+			case "type":
+				typ = attr.Value
+			case "d":
+				path = attr.Value
+			}
+		}
+		// Stroke paths have IDs like "kvg:04e00-s1".
+		if !strings.Contains(id, "-s") {
+			continue
+		}
+
+		s := Stroke{Type: typ, Class: ClassifyType(typ)}
+		if s.Class == Unknown {
+			if s.Class, err = ClassifyPath(path); err != nil {
+				return nil, fmt.Errorf("stroke %s: %w", id, err)
+			}
+		}
+		k.Strokes = append(k.Strokes, s)
+	}
+
+	if len(k.Strokes) == 0 {
+		return nil, fmt.Errorf("no strokes found")
+	}
+	return k, nil
+}
+
+// ParseFile reads a single KanjiVG file.
+func ParseFile(filename string) (*Kanji, error) {
+	char, ok := charFromFilename(filename)
+	if !ok {
+		return nil, fmt.Errorf("%s: not a KanjiVG kanji filename", filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	k, err := Parse(f, char)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return k, nil
+}
+
+// LoadDir reads every kanji file in a directory of KanjiVG SVG files, such as
+// the "kanji" directory of a KanjiVG release.  Variant files are skipped.  Files
+// that can't be parsed are returned as errors and don't stop the others from
+// loading.
+func LoadDir(dir string) (map[string]*Kanji, []error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.svg"))
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	ret := map[string]*Kanji{}
+	var errs []error
+	for _, name := range names {
+		if _, ok := charFromFilename(name); !ok {
+			continue
+		}
+		k, err := ParseFile(name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ret[k.Char] = k
+	}
+	return ret, errs
+}
@@ -0,0 +1,74 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strokes
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const svg = `<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="109" height="109" viewBox="0 0 109 109">
+<g id="kvg:StrokePaths_04eba" style="fill:none;stroke:#000000;stroke-width:3;">
+<g id="kvg:04eba" kvg:element="人" kvg:radical="general">
+	<path id="kvg:04eba-s1" kvg:type="㇒" d="M54.5,20.5c0.25,1.5,0.41,3.67-0.24,5.88C49.5,41.75,37.25,63.75,15.5,80.5"/>
+	<path id="kvg:04eba-s2" d="M53.5,43.5c8.5,9,27.25,33.25,37.5,38.5"/>
+</g>
+</g>
+<g id="kvg:StrokeNumbers_04eba">
+	<text transform="matrix(1 0 0 1 45.50 17.50)">1</text>
+	<text transform="matrix(1 0 0 1 60.50 51.50)">2</text>
+</g>
+</svg>`
+
+func TestParse(t *testing.T) {
+	k, err := Parse(strings.NewReader(svg), "人")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Kanji{Char: "人", Strokes: []Stroke{
+		{Type: "㇒", Class: LeftFalling},
+		{Class: RightFalling},
+	}}
+	if !reflect.DeepEqual(k, want) {
+		t.Errorf("got %+v, want %+v", k, want)
+	}
+
+	if _, err := Parse(strings.NewReader(`<svg><path id="other" d="M1,1L2,2"/></svg>`), "x"); err == nil {
+		t.Errorf("no error for a file with no strokes")
+	}
+	if _, err := Parse(strings.NewReader(`<svg><path id="kvg:04eba-s1" d="M1"/></svg>`), "x"); err == nil {
+		t.Errorf("no error for a bad path")
+	}
+}
+
+func TestCharFromFilename(t *testing.T) {
+	for _, tc := range []struct {
+		filename string
+		want     string
+		ok       bool
+	}{
+		{"kanji/04e00.svg", "一", true},
+		{"04eba.svg", "人", true},
+		{"04e00-Kaisho.svg", "", false},
+		{"readme.svg", "", false},
+	} {
+		got, ok := charFromFilename(tc.filename)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("charFromFilename(%q) = %q, %v, want %q, %v", tc.filename, got, ok, tc.want, tc.ok)
+		}
+	}
+}
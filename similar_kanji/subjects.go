@@ -0,0 +1,136 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"sort"
+
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+// Unresolved is a similar kanji that has no WaniKani kanji subject.
+type Unresolved struct {
+	SubjectID    int64   `json:"subject_id"`
+	Kanji        string  `json:"kanji"`
+	SimilarKanji string  `json:"similar_kanji"`
+	Score        float32 `json:"score"`
+}
+
+// SubjectReport describes the result of ApplyToSubjects.
+type SubjectReport struct {
+	// Number of kanji subjects that were given at least one similar kanji.
+	Updated int `json:"updated"`
+
+	// Kanji subjects that aren't in the index at all.
+	NotInIndex []string `json:"not_in_index"`
+
+	// Similar kanji that were dropped because WaniKani doesn't teach them.
+	Unresolved []Unresolved `json:"unresolved"`
+}
+
+// RemoveUnresolved removes similar kanji that have no WaniKani kanji subject,
+// so that a limit applied afterwards keeps as many kanji as the app can show.
+// Returns the ones removed from the lists of kanji subjects.
+func (idx *Index) RemoveUnresolved(subjects []*proto.Subject) []Unresolved {
+	ids := map[string]int64{}
+	for _, s := range subjects {
+		if s.Kanji != nil {
+			ids[s.GetJapanese()] = s.GetId()
+		}
+	}
+
+	var keys []string
+	for kanji := range idx.data {
+		keys = append(keys, kanji)
+	}
+	sort.Strings(keys)
+
+	var ret []Unresolved
+	for _, kanji := range keys {
+		var kept []Entry
+		for _, e := range idx.data[kanji] {
+			if _, ok := ids[e.Kan]; ok {
+				kept = append(kept, e)
+				continue
+			}
+			if id, ok := ids[kanji]; ok {
+				ret = append(ret, Unresolved{
+					SubjectID:    id,
+					Kanji:        kanji,
+					SimilarKanji: e.Kan,
+					Score:        e.Score,
+				})
+			}
+		}
+		if len(kept) == 0 {
+			delete(idx.data, kanji)
+		} else {
+			idx.data[kanji] = kept
+		}
+	}
+	return ret
+}
+
+// ApplyToSubjects replaces the visually similar kanji of every kanji subject
+// with the contents of the index.  Each similar kanji is resolved to the ID of
+// the WaniKani subject with the same character.  The index should be sorted
+// first.
+func (idx *Index) ApplyToSubjects(subjects []*proto.Subject) *SubjectReport {
+	ids := map[string]int64{}
+	for _, s := range subjects {
+		if s.Kanji != nil {
+			ids[s.GetJapanese()] = s.GetId()
+		}
+	}
+
+	report := &SubjectReport{}
+	for _, s := range subjects {
+		if s.Kanji == nil {
+			continue
+		}
+		entries, ok := idx.data[s.GetJapanese()]
+		if !ok {
+			report.NotInIndex = append(report.NotInIndex, s.GetJapanese())
+		}
+
+		var str string
+		var similarIDs []int64
+		for _, e := range entries {
+			id, ok := ids[e.Kan]
+			if !ok {
+				report.Unresolved = append(report.Unresolved, Unresolved{
+					SubjectID:    s.GetId(),
+					Kanji:        s.GetJapanese(),
+					SimilarKanji: e.Kan,
+					Score:        e.Score,
+				})
+				continue
+			}
+			str += e.Kan
+			similarIDs = append(similarIDs, id)
+		}
+
+		if len(similarIDs) == 0 {
+			s.Kanji.VisuallySimilarKanji = nil
+		} else {
+			s.Kanji.VisuallySimilarKanji = &str
+			report.Updated++
+		}
+		s.Kanji.VisuallySimilarKanjiIds = similarIDs
+	}
+
+	sort.Strings(report.NotInIndex)
+	return report
+}
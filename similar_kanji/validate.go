@@ -0,0 +1,186 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Problem is something wrong with one kanji or entry in a source file.
+type Problem struct {
+	File  string `json:"file"`
+	Kanji string `json:"kanji"`
+
+	// Entry is the position of the entry in the kanji's list, starting at 0,
+	// or -1 if the problem is with the kanji itself.
+	Entry   int    `json:"entry"`
+	Similar string `json:"similar,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func (p Problem) Error() string {
+	if p.Entry < 0 {
+		return fmt.Sprintf("%s: %q: %s", p.File, p.Kanji, p.Message)
+	}
+	return fmt.Sprintf("%s: %q entry %d (%q): %s", p.File, p.Kanji, p.Entry, p.Similar, p.Message)
+}
+
+// ValidationError is returned in strict mode when a source has problems.
+type ValidationError struct {
+	File     string
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %d problem(s)", e.File, len(e.Problems))
+	for _, p := range e.Problems {
+		sb.WriteString("\n  ")
+		sb.WriteString(p.Error())
+	}
+	return sb.String()
+}
+
+// sourceKanji is one kanji and its similar kanji, in the order they appear in
+// a source file.
+type sourceKanji struct {
+	kanji   string
+	entries EntryList
+}
+
+// readSourceFile reads a scored or unscored file, keeping the order of its
+// keys so problems are reported in file order and duplicate keys are found.
+func readSourceFile(filename string, scored bool, score float32) ([]sourceKanji, []Problem, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	if tok, err := d.Token(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", filename, err)
+	} else if tok != json.Delim('{') {
+		return nil, nil, fmt.Errorf("%s: expected a JSON object, got %v", filename, tok)
+	}
+
+	var ret []sourceKanji
+	var problems []Problem
+	seen := map[string]bool{}
+	for d.More() {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		kanji := tok.(string)
+
+		var entries EntryList
+		if scored {
+			err = d.Decode(&entries)
+		} else {
+			var similar []string
+			err = d.Decode(&similar)
+			for _, s := range similar {
+				entries = append(entries, Entry{Kan: s, Score: score})
+			}
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %q: %w", filename, kanji, err)
+		}
+
+		if seen[kanji] {
+			problems = append(problems, Problem{filename, kanji, -1, "", "duplicate key"})
+			continue
+		}
+		seen[kanji] = true
+		ret = append(ret, sourceKanji{kanji, entries})
+	}
+	return ret, problems, nil
+}
+
+func isKanji(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	return size == len(s) && unicode.Is(unicode.Han, r)
+}
+
+// validate checks one kanji from a source file, and returns the entries that
+// have no problems.
+func validate(filename string, k sourceKanji) (EntryList, []Problem) {
+	var problems []Problem
+	if utf8.RuneCountInString(k.kanji) != 1 {
+		problems = append(problems, Problem{filename, k.kanji, -1, "", "key is not a single character"})
+	} else if !isKanji(k.kanji) {
+		problems = append(problems, Problem{filename, k.kanji, -1, "", "key is not a CJK character"})
+	}
+	if len(problems) != 0 {
+		return nil, problems
+	}
+
+	var ret EntryList
+	seen := map[string]bool{}
+	for i, e := range k.entries {
+		problem := func(format string, args ...interface{}) {
+			problems = append(problems, Problem{filename, k.kanji, i, e.Kan, fmt.Sprintf(format, args...)})
+		}
+		switch {
+		case utf8.RuneCountInString(e.Kan) != 1:
+			problem("not a single character")
+		case !isKanji(e.Kan):
+			problem("not a CJK character")
+		case e.Kan == k.kanji:
+			problem("similar to itself")
+		case seen[e.Kan]:
+			problem("duplicate entry")
+		case math.IsNaN(float64(e.Score)) || e.Score < 0 || e.Score > 1:
+			problem("score %v outside [0, 1]", e.Score)
+		default:
+			seen[e.Kan] = true
+			ret = append(ret, e)
+		}
+	}
+	return ret, problems
+}
+
+// loadSourceFile reads and validates a source file.  In strict mode any
+// problem fails the whole file, otherwise the bad kanji and entries are
+// skipped and recorded in idx.Problems.
+func (idx *Index) loadSourceFile(filename string, scored bool, score float32) ([]sourceKanji, error) {
+	data, problems, err := readSourceFile(filename, scored, score)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []sourceKanji
+	for _, k := range data {
+		entries, p := validate(filename, k)
+		problems = append(problems, p...)
+		if len(entries) != 0 {
+			ret = append(ret, sourceKanji{k.kanji, entries})
+		}
+	}
+
+	if len(problems) != 0 && idx.Strict {
+		return nil, &ValidationError{filename, problems}
+	}
+	idx.Problems = append(idx.Problems, problems...)
+	return ret, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func problemMessages(problems []Problem) []string {
+	var ret []string
+	for _, p := range problems {
+		ret = append(ret, p.Error())
+	}
+	return ret
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		kanji    string
+		entries  EntryList
+		want     EntryList
+		problems []string
+	}{
+		{
+			name:    "valid",
+			kanji:   "未",
+			entries: EntryList{{Kan: "末", Score: 1}, {Kan: "本", Score: 0}},
+			want:    EntryList{{Kan: "末", Score: 1}, {Kan: "本", Score: 0}},
+		},
+		{
+			name:  "no entries",
+			kanji: "未",
+		},
+		{
+			name:     "long key",
+			kanji:    "未末",
+			entries:  EntryList{{Kan: "本"}},
+			problems: []string{`f: "未末": key is not a single character`},
+		},
+		{
+			name:     "empty key",
+			kanji:    "",
+			problems: []string{`f: "": key is not a single character`},
+		},
+		{
+			name:     "non-CJK key",
+			kanji:    "a",
+			entries:  EntryList{{Kan: "本"}},
+			problems: []string{`f: "a": key is not a CJK character`},
+		},
+		{
+			name:  "bad entries are skipped",
+			kanji: "未",
+			entries: EntryList{
+				{Kan: "末本"},
+				{Kan: "ア"},
+				{Kan: "未"},
+				{Kan: "末", Score: 0.5},
+				{Kan: "末", Score: 0.6},
+				{Kan: "本", Score: -0.1},
+				{Kan: "本", Score: 1.5},
+				{Kan: "本", Score: float32(math.NaN())},
+			},
+			want: EntryList{{Kan: "末", Score: 0.5}},
+			problems: []string{
+				`f: "未" entry 0 ("末本"): not a single character`,
+				`f: "未" entry 1 ("ア"): not a CJK character`,
+				`f: "未" entry 2 ("未"): similar to itself`,
+				`f: "未" entry 4 ("末"): duplicate entry`,
+				`f: "未" entry 5 ("本"): score -0.1 outside [0, 1]`,
+				`f: "未" entry 6 ("本"): score 1.5 outside [0, 1]`,
+				`f: "未" entry 7 ("本"): score NaN outside [0, 1]`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, problems := validate("f", sourceKanji{tc.kanji, tc.entries})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got entries %v, want %v", got, tc.want)
+			}
+			if got := problemMessages(problems); !reflect.DeepEqual(got, tc.problems) {
+				t.Errorf("got problems %q, want %q", got, tc.problems)
+			}
+		})
+	}
+}
+
+func TestLoadSourceFile(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		json     string
+		scored   bool
+		score    float32
+		strict   bool
+		want     []sourceKanji
+		problems []string
+		fails    bool
+	}{
+		{
+			name: "empty source",
+			json: `{}`,
+		},
+		{
+			name:   "empty scored source",
+			json:   `{}`,
+			scored: true,
+			strict: true,
+		},
+		{
+			name:  "unscored",
+			json:  `{"未": ["末", "本"]}`,
+			score: 0.5,
+			want:  []sourceKanji{{"未", EntryList{{Kan: "末", Score: 0.5}, {Kan: "本", Score: 0.5}}}},
+		},
+		{
+			name:  "unscored with a weight of 0",
+			json:  `{"未": ["末"]}`,
+			score: 0,
+			want:  []sourceKanji{{"未", EntryList{{Kan: "末", Score: 0}}}},
+		},
+		{
+			name:   "unscored with a weight above 1",
+			json:   `{"未": ["末"]}`,
+			score:  2,
+			strict: true,
+			fails:  true,
+		},
+		{
+			name:   "scored in file order",
+			json:   `{"本": [{"kan": "体", "score": 0}], "未": [{"kan": "末", "score": 0.5}]}`,
+			scored: true,
+			want: []sourceKanji{
+				{"本", EntryList{{Kan: "体", Score: 0}}},
+				{"未", EntryList{{Kan: "末", Score: 0.5}}},
+			},
+		},
+		{
+			name:     "lenient",
+			json:     `{"未": ["末"], "未": ["本"], "a": ["本"], "本": ["本"]}`,
+			score:    1,
+			want:     []sourceKanji{{"未", EntryList{{Kan: "末", Score: 1}}}},
+			problems: []string{`%s: "未": duplicate key`, `%s: "a": key is not a CJK character`, `%s: "本" entry 0 ("本"): similar to itself`},
+		},
+		{
+			name:   "strict",
+			json:   `{"未": ["末"], "本": ["本"]}`,
+			score:  1,
+			strict: true,
+			fails:  true,
+		},
+		{
+			name:  "not an object",
+			json:  `["未"]`,
+			fails: true,
+		},
+		{
+			name:  "wrong format",
+			json:  `{"未": [{"kan": "末", "score": 0.5}]}`,
+			fails: true,
+		},
+		{
+			name:  "truncated",
+			json:  `{"未": ["末"`,
+			fails: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "source.json")
+			if err := os.WriteFile(path, []byte(tc.json), 0644); err != nil {
+				t.Fatal(err)
+			}
+			idx := Create()
+			idx.Strict = tc.strict
+			got, err := idx.loadSourceFile(path, tc.scored, tc.score)
+			if tc.fails {
+				if err == nil {
+					t.Errorf("no error")
+				}
+				var verr *ValidationError
+				if tc.strict && !errors.As(err, &verr) {
+					t.Errorf("got %v, want a ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			var want []string
+			for _, p := range tc.problems {
+				want = append(want, fmt.Sprintf(p, path))
+			}
+			if got := problemMessages(idx.Problems); !reflect.DeepEqual(got, want) {
+				t.Errorf("got problems %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAddUnscoredFileWeightZero(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "source.json")
+	if err := os.WriteFile(path, []byte(`{"未": ["末"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	idx := Create()
+	if err := idx.AddUnscoredFile("s", path, 0); err != nil {
+		t.Fatal(err)
+	}
+	idx.Sort()
+	if got := idx.Compact(); !reflect.DeepEqual(got, map[string]string{"未": "末"}) {
+		t.Errorf("got %v, want the pair with a score of 0", got)
+	}
+}
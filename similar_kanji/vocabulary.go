@@ -0,0 +1,175 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/davidsansome/tsurukame/proto"
+)
+
+// SimilarWord is a vocabulary word that differs from another by one visually
+// similar kanji.
+type SimilarWord struct {
+	SubjectID int64   `json:"subject_id"`
+	Japanese  string  `json:"japanese"`
+	Score     float32 `json:"score"`
+
+	// Kanji in the original word that was replaced by SimilarKanji.
+	Kanji        string `json:"kanji"`
+	SimilarKanji string `json:"similar_kanji"`
+
+	SharedReading bool `json:"shared_reading,omitempty"`
+}
+
+// VocabularyResult is the similar words of one vocabulary subject.
+type VocabularyResult struct {
+	SubjectID int64         `json:"subject_id"`
+	Japanese  string        `json:"japanese"`
+	Similar   []SimilarWord `json:"similar"`
+}
+
+// VocabularyOptions controls which words SimilarVocabulary returns.
+type VocabularyOptions struct {
+	// SharedReading keeps only words that share a reading.
+	SharedReading bool
+
+	// Limit is the maximum number of similar words for each word, or 0 for no
+	// limit.
+	Limit int
+}
+
+// replaceEach returns a copy of s for each occurrence of old, with just that
+// occurrence replaced by new.
+func replaceEach(s, old, new string) []string {
+	var ret []string
+	for i := 0; ; {
+		j := strings.Index(s[i:], old)
+		if j == -1 {
+			return ret
+		}
+		i += j
+		ret = append(ret, s[:i]+new+s[i+len(old):])
+		i += len(old)
+	}
+}
+
+// SimilarVocabulary finds vocabulary that differs from each vocabulary subject
+// by replacing one of its kanji with a similar kanji from the index, like 未来
+// and 末来.  A kanji that appears more than once is replaced at each place in
+// turn.  Only the kanji listed in a word's component_subject_ids are replaced.
+// Words are ranked by the score of the replaced kanji, then by subject ID.  The
+// index should be sorted first.
+func (idx *Index) SimilarVocabulary(subjects []*proto.Subject, opts VocabularyOptions) []VocabularyResult {
+	kanjiByID := map[int64]string{}
+	vocabByJapanese := map[string]*proto.Subject{}
+	for _, s := range subjects {
+		switch {
+		case s.Kanji != nil:
+			kanjiByID[s.GetId()] = s.GetJapanese()
+		case s.Vocabulary != nil:
+			vocabByJapanese[s.GetJapanese()] = s
+		}
+	}
+
+	readings := func(s *proto.Subject) map[string]bool {
+		ret := map[string]bool{}
+		for _, r := range s.Readings {
+			ret[r.GetReading()] = true
+		}
+		return ret
+	}
+
+	var ret []VocabularyResult
+	for _, s := range subjects {
+		if s.Vocabulary == nil {
+			continue
+		}
+		word := s.GetJapanese()
+		isComponent := map[string]bool{}
+		for _, id := range s.ComponentSubjectIds {
+			if k, ok := kanjiByID[id]; ok {
+				isComponent[k] = true
+			}
+		}
+		// Replace the kanji in the order they appear in the word, so a tie
+		// between two replacements always keeps the earlier kanji.
+		var components []string
+		for _, r := range word {
+			if k := string(r); isComponent[k] {
+				components = append(components, k)
+				delete(isComponent, k)
+			}
+		}
+
+		best := map[int64]SimilarWord{}
+		for _, kanji := range components {
+			for _, e := range idx.data[kanji] {
+				for _, candidate := range replaceEach(word, kanji, e.Kan) {
+					other, ok := vocabByJapanese[candidate]
+					if !ok || other.GetId() == s.GetId() {
+						continue
+					}
+					if existing, ok := best[other.GetId()]; ok && existing.Score >= e.Score {
+						continue
+					}
+					best[other.GetId()] = SimilarWord{
+						SubjectID:    other.GetId(),
+						Japanese:     other.GetJapanese(),
+						Score:        e.Score,
+						Kanji:        kanji,
+						SimilarKanji: e.Kan,
+					}
+				}
+			}
+		}
+		if len(best) == 0 {
+			continue
+		}
+
+		r := VocabularyResult{SubjectID: s.GetId(), Japanese: word}
+		ours := readings(s)
+		for _, w := range best {
+			for reading := range readings(vocabByJapanese[w.Japanese]) {
+				if ours[reading] {
+					w.SharedReading = true
+				}
+			}
+			if opts.SharedReading && !w.SharedReading {
+				continue
+			}
+			r.Similar = append(r.Similar, w)
+		}
+		if len(r.Similar) == 0 {
+			continue
+		}
+
+		sort.Slice(r.Similar, func(i, j int) bool {
+			a, b := r.Similar[i], r.Similar[j]
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+			return a.SubjectID < b.SubjectID
+		})
+		if opts.Limit > 0 && len(r.Similar) > opts.Limit {
+			r.Similar = r.Similar[:opts.Limit]
+		}
+		ret = append(ret, r)
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].SubjectID < ret[j].SubjectID })
+	return ret
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 David Sansome
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package similar_kanji
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/davidsansome/tsurukame/proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+func TestReplaceEach(t *testing.T) {
+	for _, tc := range []struct {
+		s, old, new string
+		want        []string
+	}{
+		{"未来", "未", "末", []string{"末来"}},
+		{"時時", "時", "持", []string{"持時", "時持"}},
+		{"人々", "人", "入", []string{"入々"}},
+		{"未来", "本", "木", nil},
+	} {
+		if got := replaceEach(tc.s, tc.old, tc.new); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("replaceEach(%q, %q, %q) = %q, want %q", tc.s, tc.old, tc.new, got, tc.want)
+		}
+	}
+}
+
+func kanjiSubject(id int64, japanese string) *proto.Subject {
+	return &proto.Subject{Id: gproto.Int64(id), Japanese: gproto.String(japanese), Kanji: &proto.Kanji{}}
+}
+
+func vocabSubject(id int64, japanese, reading string, components ...int64) *proto.Subject {
+	return &proto.Subject{
+		Id:                  gproto.Int64(id),
+		Japanese:            gproto.String(japanese),
+		Readings:            []*proto.Reading{{Reading: gproto.String(reading)}},
+		ComponentSubjectIds: components,
+		Vocabulary:          &proto.Vocabulary{},
+	}
+}
+
+func TestSimilarVocabularyRepeatedKanji(t *testing.T) {
+	subjects := []*proto.Subject{
+		kanjiSubject(1, "時"),
+		kanjiSubject(2, "持"),
+		vocabSubject(10, "時時", "じじ", 1),
+		vocabSubject(11, "時持", "じじ", 1, 2),
+	}
+
+	idx := Create()
+	idx.Add("a", "時", "持", 0.9)
+	idx.Sort()
+	got := idx.SimilarVocabulary(subjects, VocabularyOptions{})
+	if len(got) != 1 || got[0].SubjectID != 10 || len(got[0].Similar) != 1 || got[0].Similar[0].SubjectID != 11 {
+		t.Fatalf("got %+v, want 時時 similar to 時持", got)
+	}
+}
+
+func TestSimilarVocabulary(t *testing.T) {
+	subjects := []*proto.Subject{
+		kanjiSubject(1, "未"),
+		kanjiSubject(2, "末"),
+		kanjiSubject(3, "来"),
+		kanjiSubject(4, "米"),
+		kanjiSubject(5, "本"),
+		vocabSubject(10, "未来", "みらい", 1, 3),
+		vocabSubject(11, "末来", "まつらい", 2, 3),
+		vocabSubject(12, "未米", "みらい", 1, 4),
+		vocabSubject(13, "本来", "ほんらい", 5, 3),
+		vocabSubject(14, "未", "み", 1),
+	}
+
+	idx := Create()
+	idx.Add("a", "未", "末", 0.5)
+	idx.Add("a", "未", "本", 0.5)
+	idx.Add("a", "来", "米", 0.9)
+	idx.Sort()
+
+	for _, tc := range []struct {
+		name string
+		opts VocabularyOptions
+		want []VocabularyResult
+	}{
+		{
+			name: "all",
+			want: []VocabularyResult{{SubjectID: 10, Japanese: "未来", Similar: []SimilarWord{
+				{SubjectID: 12, Japanese: "未米", Score: 0.9, Kanji: "来", SimilarKanji: "米", SharedReading: true},
+				{SubjectID: 11, Japanese: "末来", Score: 0.5, Kanji: "未", SimilarKanji: "末"},
+				{SubjectID: 13, Japanese: "本来", Score: 0.5, Kanji: "未", SimilarKanji: "本"},
+			}}},
+		},
+		{
+			name: "shared reading",
+			opts: VocabularyOptions{SharedReading: true},
+			want: []VocabularyResult{{SubjectID: 10, Japanese: "未来", Similar: []SimilarWord{
+				{SubjectID: 12, Japanese: "未米", Score: 0.9, Kanji: "来", SimilarKanji: "米", SharedReading: true},
+			}}},
+		},
+		{
+			name: "limit",
+			opts: VocabularyOptions{Limit: 2},
+			want: []VocabularyResult{{SubjectID: 10, Japanese: "未来", Similar: []SimilarWord{
+				{SubjectID: 12, Japanese: "未米", Score: 0.9, Kanji: "来", SimilarKanji: "米", SharedReading: true},
+				{SubjectID: 11, Japanese: "末来", Score: 0.5, Kanji: "未", SimilarKanji: "末"},
+			}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				if got := idx.SimilarVocabulary(subjects, tc.opts); !reflect.DeepEqual(got, tc.want) {
+					t.Fatalf("got %+v, want %+v", got, tc.want)
+				}
+			}
+		})
+	}
+}